@@ -0,0 +1,9 @@
+# Externally shared incident channels across the organization
+data "slack_admin_conversations" "shared_incidents" {
+  query                = "inc-"
+  search_channel_types = ["external_shared", "exclude_archived"]
+}
+
+output "shared_incident_channels" {
+  value = [for channel in data.slack_admin_conversations.shared_incidents.channels : channel.name]
+}
@@ -0,0 +1,11 @@
+data "slack_admin_users" "workspace" {
+  team_id = "T0123456789"
+}
+
+# Admins and owners without two-factor authentication
+output "admins_without_2fa" {
+  value = [
+    for user in data.slack_admin_users.workspace.users : user.email
+    if (user.is_admin || user.is_owner) && !user.has_2fa
+  ]
+}
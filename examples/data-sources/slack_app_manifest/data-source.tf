@@ -0,0 +1,13 @@
+variable "slack_config_token" {
+  type      = string
+  sensitive = true
+}
+
+data "slack_app_manifest" "deploy_bot" {
+  app_id = "AXXXXXXXXXX"
+  token  = var.slack_config_token
+}
+
+output "deploy_bot_name" {
+  value = jsondecode(data.slack_app_manifest.deploy_bot.manifest).display_information.name
+}
@@ -0,0 +1,19 @@
+data "slack_app_requests" "workspace" {
+  team_id = "T0123456789"
+}
+
+# Surface pending requests in every plan, so they get reviewed
+check "app_requests" {
+  assert {
+    condition     = length(data.slack_app_requests.workspace.requests) == 0
+    error_message = "Apps awaiting review: ${join(", ", [for request in data.slack_app_requests.workspace.requests : request.app_name])}"
+  }
+}
+
+# Restrict requested apps that are known to be disallowed
+resource "slack_app_restriction" "denied" {
+  for_each = setintersection(data.slack_app_requests.workspace.app_ids, ["A0123456789"])
+
+  team_id = data.slack_app_requests.workspace.id
+  app_id  = each.value
+}
@@ -0,0 +1,11 @@
+data "slack_approved_apps" "workspace" {
+  team_id = "T0123456789"
+}
+
+# Fail the plan if an app was approved outside of the allowed list
+check "approved_apps" {
+  assert {
+    condition     = length(setsubtract(data.slack_approved_apps.workspace.app_ids, ["A0123456789", "A0987654321"])) == 0
+    error_message = "Unexpected apps are approved: ${join(", ", setsubtract(data.slack_approved_apps.workspace.app_ids, ["A0123456789", "A0987654321"]))}"
+  }
+}
@@ -6,3 +6,23 @@ data "slack_channel" "channel_by_id" {
 data "slack_channel" "channel_by_name" {
   name = "some-channel"
 }
+
+data "slack_channel" "private_channel_by_name" {
+  name  = "some-private-channel"
+  types = ["public_channel", "private_channel"]
+}
+
+data "slack_channel" "channel_with_members" {
+  id              = "CXXXXXXXXXX"
+  include_members = true
+}
+
+data "slack_channel" "maybe_existing" {
+  name              = "incident-response"
+  fail_if_not_found = false
+}
+
+resource "slack_channel" "incident_response" {
+  count = data.slack_channel.maybe_existing.found ? 0 : 1
+  name  = "incident-response"
+}
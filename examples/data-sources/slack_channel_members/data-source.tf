@@ -1,3 +1,10 @@
 data "slack_channel_members" "members" {
   id = "CXXXXXXXXXX"
 }
+
+data "slack_channel_members" "humans" {
+  id                  = "CXXXXXXXXXX"
+  exclude_bots        = true
+  exclude_deactivated = true
+  include_details     = true
+}
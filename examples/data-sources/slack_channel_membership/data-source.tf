@@ -0,0 +1,8 @@
+data "slack_channel_membership" "on_call" {
+  channel_id = "CXXXXXXXXXX"
+  user_id    = "UXXXXXXXXXX"
+}
+
+output "on_call_needs_invite" {
+  value = !data.slack_channel_membership.on_call.is_member
+}
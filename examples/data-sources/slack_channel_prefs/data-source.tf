@@ -0,0 +1,11 @@
+data "slack_channel_prefs" "announcements" {
+  channel_id = "C123ABC456"
+}
+
+check "announcements_admin_only" {
+  assert {
+    # who_can_post is null when everyone can post.
+    condition     = try(!contains(data.slack_channel_prefs.announcements.who_can_post.types, "regular"), false)
+    error_message = "Everyone can post in the announcements channel."
+  }
+}
@@ -0,0 +1,18 @@
+data "slack_connect_invites" "outgoing" {
+  direction = "outgoing"
+}
+
+locals {
+  # Invites sent more than two weeks ago that are still pending.
+  stale_invites = [
+    for invite in data.slack_connect_invites.outgoing.invites : "#${invite.channel_name} (${invite.id})"
+    if timecmp(provider::slack::parse_slack_ts(tostring(invite.date_created)), timeadd(plantimestamp(), "-336h")) < 0
+  ]
+}
+
+check "stale_connect_invites" {
+  assert {
+    condition     = length(local.stale_invites) == 0
+    error_message = "Revoke these Slack Connect invites: ${join(", ", local.stale_invites)}"
+  }
+}
@@ -0,0 +1,9 @@
+data "slack_enterprise" "current" {}
+
+output "organization" {
+  value = data.slack_enterprise.current.is_enterprise ? data.slack_enterprise.current.name : "standalone workspace"
+}
+
+output "workspace_ids" {
+  value = data.slack_enterprise.current.team_ids
+}
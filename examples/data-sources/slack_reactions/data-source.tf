@@ -0,0 +1,21 @@
+resource "slack_notification" "approval" {
+  channel = "C0123ABCDEF"
+  text    = "Deploying {{ .version }} to production, approve with :white_check_mark:"
+  triggers = {
+    version = var.version
+  }
+}
+
+data "slack_reactions" "approval" {
+  channel_id = slack_notification.approval.channel
+  timestamp  = slack_notification.approval.id
+}
+
+check "approved" {
+  assert {
+    condition = anytrue([
+      for reaction in data.slack_reactions.approval.reactions : reaction.name == "white_check_mark"
+    ])
+    error_message = "The deploy of ${var.version} has not been approved yet."
+  }
+}
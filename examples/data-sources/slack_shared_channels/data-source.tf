@@ -0,0 +1,20 @@
+data "slack_shared_channels" "all" {
+  exclude_archived = true
+}
+
+locals {
+  # Partners we have a contract with.
+  approved_partners = ["T0ACMECORP", "T0GLOBEX"]
+
+  unapproved_connections = {
+    for channel in data.slack_shared_channels.all.channels : channel.name => setsubtract(channel.connected_team_ids, local.approved_partners)
+    if length(setsubtract(channel.connected_team_ids, local.approved_partners)) > 0
+  }
+}
+
+check "slack_connect_audit" {
+  assert {
+    condition     = length(local.unapproved_connections) == 0
+    error_message = "Channels shared with unapproved organizations: ${jsonencode(local.unapproved_connections)}"
+  }
+}
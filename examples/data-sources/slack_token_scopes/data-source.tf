@@ -0,0 +1,13 @@
+data "slack_token_scopes" "current" {}
+
+resource "slack_usergroup" "platform" {
+  name   = "Platform"
+  handle = "platform"
+
+  lifecycle {
+    precondition {
+      condition     = contains(data.slack_token_scopes.current.scopes, "usergroups:write")
+      error_message = "The Slack token needs the usergroups:write scope to manage User Groups."
+    }
+  }
+}
@@ -6,3 +6,20 @@ data "slack_user" "user_by_id" {
 data "slack_user" "user_by_name" {
   name = "steve"
 }
+
+# Resolve the bot user behind the bot_id of an integration's payload.
+data "slack_user" "user_by_bot_id" {
+  bot_id = "BXXXXXXXXXX"
+}
+
+# Look up people from HR data who may not have joined Slack yet.
+data "slack_user" "new_hires" {
+  for_each = toset(["alice@example.com", "bob@example.com"])
+
+  email             = each.value
+  fail_if_not_found = false
+}
+
+output "new_hires_in_slack" {
+  value = [for user in data.slack_user.new_hires : user.id if user.found]
+}
@@ -0,0 +1,8 @@
+data "slack_user_sessions" "suspect" {
+  user_id = "U0123456789"
+  team_id = "T0123456789"
+}
+
+output "suspect_session_ips" {
+  value = distinct([for session in data.slack_user_sessions.suspect.sessions : session.created.ip])
+}
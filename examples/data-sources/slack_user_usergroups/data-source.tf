@@ -0,0 +1,14 @@
+data "slack_user" "departed" {
+  email = "departed@example.com"
+}
+
+data "slack_user_usergroups" "departed" {
+  user_id = data.slack_user.departed.id
+}
+
+check "offboarded" {
+  assert {
+    condition     = length(data.slack_user_usergroups.departed.usergroup_ids) == 0
+    error_message = "departed@example.com is still a member of: ${join(", ", data.slack_user_usergroups.departed.usergroups[*].handle)}"
+  }
+}
@@ -0,0 +1,8 @@
+data "slack_usergroup_membership" "platform" {
+  usergroup_id = "SXXXXXXXXXX"
+  user_id      = "UXXXXXXXXXX"
+}
+
+output "is_platform_engineer" {
+  value = data.slack_usergroup_membership.platform.is_member
+}
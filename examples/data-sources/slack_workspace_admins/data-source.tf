@@ -0,0 +1,6 @@
+data "slack_workspace_admins" "current" {}
+
+# Mention every workspace admin in alerts.
+output "admin_mentions" {
+  value = join(" ", [for id in data.slack_workspace_admins.current.admin_ids : "<@${id}>"])
+}
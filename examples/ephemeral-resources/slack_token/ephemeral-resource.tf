@@ -0,0 +1,22 @@
+variable "client_secret" {
+  type      = string
+  sensitive = true
+}
+
+variable "app_refresh_token" {
+  type      = string
+  sensitive = true
+}
+
+# Refresh a rotating app access token and use it to configure a second
+# provider instance.
+ephemeral "slack_token" "app" {
+  client_id     = "0123456789.0123456789"
+  client_secret = var.client_secret
+  refresh_token = var.app_refresh_token
+}
+
+provider "slack" {
+  alias = "app"
+  token = ephemeral.slack_token.app.token
+}
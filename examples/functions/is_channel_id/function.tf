@@ -0,0 +1,12 @@
+variable "alerts_channel_id" {
+  type = string
+
+  validation {
+    condition     = provider::slack::is_channel_id(var.alerts_channel_id)
+    error_message = "The alerts channel must be a Slack channel ID, such as C0123ABCDEF, not a channel name."
+  }
+}
+
+resource "slack_channel_join" "alerts" {
+  channel_id = var.alerts_channel_id
+}
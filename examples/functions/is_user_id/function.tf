@@ -0,0 +1,8 @@
+variable "owner_id" {
+  type = string
+
+  validation {
+    condition     = provider::slack::is_user_id(var.owner_id)
+    error_message = "The owner must be a Slack user ID, such as U0123ABCDEF, not a name or email address."
+  }
+}
@@ -0,0 +1,8 @@
+variable "oncall_usergroup_id" {
+  type = string
+
+  validation {
+    condition     = provider::slack::is_usergroup_id(var.oncall_usergroup_id)
+    error_message = "The on-call User Group must be a Slack User Group ID, such as S0123ABCDEF, not a handle."
+  }
+}
@@ -0,0 +1,4 @@
+resource "slack_channel" "incidents" {
+  name  = "incidents"
+  topic = "On call: ${provider::slack::mrkdwn_link(var.on_call_schedule_url, "schedule")}"
+}
@@ -0,0 +1,12 @@
+resource "slack_notification" "deploy" {
+  channel = "C123ABC456"
+  text    = "Deployed version {{ .version }} of the API."
+
+  triggers = {
+    version = var.api_version
+  }
+}
+
+output "deployed_at" {
+  value = provider::slack::parse_slack_ts(slack_notification.deploy.id)
+}
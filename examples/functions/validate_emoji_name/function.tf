@@ -0,0 +1,13 @@
+variable "alias_name" {
+  type = string
+
+  validation {
+    condition     = can(provider::slack::validate_emoji_name(var.alias_name))
+    error_message = "The alias name must be a valid custom emoji name."
+  }
+}
+
+resource "slack_emoji_alias" "ship_it" {
+  name      = provider::slack::validate_emoji_name(var.alias_name)
+  alias_for = "shipit"
+}
@@ -0,0 +1,17 @@
+list "slack_channel" "all" {
+  provider = slack
+
+  config {
+    types = ["public_channel", "private_channel"]
+  }
+}
+
+# Generate import blocks for the channels of a team with:
+#   terraform query -generate-config-out=team_channels.tf
+list "slack_channel" "team" {
+  provider = slack
+
+  config {
+    name_prefix = "team-"
+  }
+}
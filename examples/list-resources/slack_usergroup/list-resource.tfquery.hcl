@@ -0,0 +1,3 @@
+list "slack_usergroup" "all" {
+  provider = slack
+}
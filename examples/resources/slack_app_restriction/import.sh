@@ -0,0 +1,5 @@
+# A restriction in a workspace
+terraform import slack_app_restriction.workspace "T0123456789:A0123456789"
+
+# A restriction across an organization
+terraform import slack_app_restriction.organization "E0123456789:A0987654321"
@@ -0,0 +1,11 @@
+# Restrict an app in a single workspace
+resource "slack_app_restriction" "workspace" {
+  app_id  = "A0123456789"
+  team_id = "T0123456789"
+}
+
+# Restrict an app across the whole organization
+resource "slack_app_restriction" "organization" {
+  app_id        = "A0987654321"
+  enterprise_id = "E0123456789"
+}
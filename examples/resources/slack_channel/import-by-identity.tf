@@ -0,0 +1,6 @@
+import {
+  to = slack_channel.demo
+  identity = {
+    id = "C123ABC456"
+  }
+}
@@ -0,0 +1 @@
+terraform import slack_channel_archive.incident "C123ABC456"
@@ -0,0 +1,3 @@
+resource "slack_channel_archive" "incident" {
+  channel_id = "C123ABC456"
+}
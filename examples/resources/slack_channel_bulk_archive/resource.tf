@@ -0,0 +1,8 @@
+# Archive the channels of a project when tearing it down.
+resource "slack_channel_bulk_archive" "project" {
+  channel_ids = [
+    "C0123456789",
+    "C0123456790",
+    "C0123456791",
+  ]
+}
@@ -0,0 +1,6 @@
+# Remove a partner's workspace from a shared incident channel once the
+# contract ends.
+resource "slack_channel_disconnect" "acme" {
+  channel_id       = "C0123ABCDEF"
+  leaving_team_ids = ["T0ACMECORP"]
+}
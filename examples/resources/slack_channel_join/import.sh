@@ -0,0 +1 @@
+terraform import slack_channel_join.announcements "C123ABC456"
@@ -0,0 +1,3 @@
+resource "slack_channel_join" "announcements" {
+  channel_id = "C123ABC456"
+}
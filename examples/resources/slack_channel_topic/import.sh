@@ -0,0 +1 @@
+terraform import slack_channel_topic.incidents "C123ABC456"
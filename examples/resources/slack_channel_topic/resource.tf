@@ -0,0 +1,8 @@
+variable "oncall_user_id" {
+  type = string
+}
+
+resource "slack_channel_topic" "incidents" {
+  channel_id = "C123ABC456"
+  topic      = "On call: <@${var.oncall_user_id}>"
+}
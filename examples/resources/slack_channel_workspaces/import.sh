@@ -0,0 +1 @@
+terraform import slack_channel_workspaces.platform_alerts C0123456789
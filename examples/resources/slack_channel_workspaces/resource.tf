@@ -0,0 +1,5 @@
+# Move a channel from the engineering workspace to the platform workspace
+resource "slack_channel_workspaces" "platform_alerts" {
+  channel_id      = "C0123456789"
+  target_team_ids = ["T0123456789"]
+}
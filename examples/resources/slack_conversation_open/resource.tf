@@ -0,0 +1,9 @@
+# Open a direct message with the on-call engineer, so alerts can be sent to
+# them directly.
+resource "slack_conversation_open" "on_call" {
+  user_ids = ["U0123456789"]
+}
+
+output "on_call_channel_id" {
+  value = slack_conversation_open.on_call.id
+}
@@ -0,0 +1 @@
+terraform import slack_emoji_alias.approve "approve"
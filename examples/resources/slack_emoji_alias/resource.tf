@@ -0,0 +1,4 @@
+resource "slack_emoji_alias" "approve" {
+  name      = "approve"
+  alias_for = "white_check_mark"
+}
@@ -0,0 +1 @@
+terraform import slack_function_distribution.approvals "Fn0123456789"
@@ -0,0 +1,5 @@
+resource "slack_function_distribution" "approvals" {
+  function_id     = "Fn0123456789"
+  permission_type = "named_entities"
+  user_ids        = ["U0123456789", "U0987654321"]
+}
@@ -0,0 +1,8 @@
+resource "slack_notification" "deploy" {
+  channel = "C123ABC456"
+  text    = "Deployed version {{ .version }} of the API."
+
+  triggers = {
+    version = var.api_version
+  }
+}
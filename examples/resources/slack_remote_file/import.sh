@@ -0,0 +1 @@
+terraform import slack_remote_file.runbook "F0123ABCDEF"
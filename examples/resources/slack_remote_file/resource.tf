@@ -0,0 +1,6 @@
+resource "slack_remote_file" "runbook" {
+  external_id  = "runbook-oncall"
+  external_url = "https://docs.google.com/document/d/1abc"
+  title        = "On-call runbook"
+  filetype     = "gdoc"
+}
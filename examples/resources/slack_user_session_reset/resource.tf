@@ -0,0 +1,8 @@
+# Log a compromised user out everywhere. Changing the incident logs them out
+# again.
+resource "slack_user_session_reset" "compromised" {
+  user_id = "U0123456789"
+  triggers = {
+    incident = "INC-1234"
+  }
+}
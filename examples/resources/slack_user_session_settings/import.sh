@@ -0,0 +1 @@
+terraform import slack_user_session_settings.contractors "U0123456789,U0987654321"
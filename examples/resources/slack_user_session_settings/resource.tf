@@ -0,0 +1,6 @@
+# Sign contractors out after a day, and whenever they quit their browser
+resource "slack_user_session_settings" "contractors" {
+  user_ids                 = ["U0123456789", "U0987654321"]
+  duration                 = 86400
+  desktop_app_browser_quit = true
+}
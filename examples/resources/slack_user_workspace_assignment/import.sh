@@ -0,0 +1 @@
+terraform import slack_user_workspace_assignment.engineering "T0123456789:U0123456789"
@@ -0,0 +1,14 @@
+resource "slack_user_workspace_assignment" "engineering" {
+  team_id = "T0123456789"
+  user_id = "U0123456789"
+}
+
+# A multi-channel guest, added to two of the workspace's channels until the
+# end of 2026
+resource "slack_user_workspace_assignment" "contractor" {
+  team_id       = "T0123456789"
+  user_id       = "U0987654321"
+  is_restricted = true
+  channel_ids   = ["C0123456789", "C0987654321"]
+  expiration_ts = 1798761600
+}
@@ -0,0 +1,6 @@
+import {
+  to = slack_usergroup.demo
+  identity = {
+    id = "S01ABC456"
+  }
+}
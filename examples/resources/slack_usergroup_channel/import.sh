@@ -0,0 +1 @@
+terraform import slack_usergroup_channel.oncall_incidents "S01ABC456:C01ABC123"
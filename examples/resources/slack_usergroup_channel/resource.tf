@@ -0,0 +1,4 @@
+resource "slack_usergroup_channel" "oncall_incidents" {
+  usergroup_id = slack_usergroup.oncall.id
+  channel_id   = slack_channel.incidents.id
+}
@@ -0,0 +1,337 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// adminConversationsPageLimit is the maximum page size
+// admin.conversations.search accepts.
+const adminConversationsPageLimit = 20
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AdminConversationsDataSource{}
+	_ datasource.DataSourceWithConfigure = &AdminConversationsDataSource{}
+)
+
+func NewAdminConversationsDataSource() datasource.DataSource {
+	return &AdminConversationsDataSource{}
+}
+
+// AdminConversationsDataSource defines the data source implementation.
+type AdminConversationsDataSource struct {
+	client *SlackClient
+}
+
+// AdminConversationsDataSourceModel describes the data source data model.
+type AdminConversationsDataSourceModel struct {
+	Id                 types.String `tfsdk:"id"`
+	Query              types.String `tfsdk:"query"`
+	NameRegex          types.String `tfsdk:"name_regex"`
+	TeamIds            types.Set    `tfsdk:"team_ids"`
+	ConnectedTeamIds   types.Set    `tfsdk:"connected_team_ids"`
+	SearchChannelTypes types.Set    `tfsdk:"search_channel_types"`
+	MaxResults         types.Int64  `tfsdk:"max_results"`
+	Cursor             types.String `tfsdk:"cursor"`
+	NextCursor         types.String `tfsdk:"next_cursor"`
+	ChannelIds         types.List   `tfsdk:"channel_ids"`
+	Channels           types.List   `tfsdk:"channels"`
+}
+
+// AdminConversationModel describes a single entry of channels.
+type AdminConversationModel struct {
+	Id          types.String `tfsdk:"id"`
+	Name        types.String `tfsdk:"name"`
+	IsPrivate   types.Bool   `tfsdk:"is_private"`
+	IsArchived  types.Bool   `tfsdk:"is_archived"`
+	IsExtShared types.Bool   `tfsdk:"is_ext_shared"`
+	MemberCount types.Int64  `tfsdk:"member_count"`
+}
+
+var adminConversationAttrTypes = map[string]attr.Type{
+	"id":            types.StringType,
+	"name":          types.StringType,
+	"is_private":    types.BoolType,
+	"is_archived":   types.BoolType,
+	"is_ext_shared": types.BoolType,
+	"member_count":  types.Int64Type,
+}
+
+func (d *AdminConversationsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_conversations"
+}
+
+func (d *AdminConversationsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Searches the channels of a workspace or organization with ` + "`admin.conversations.search`" + `, including private channels the token's user is not a member of.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+- ` + "`admin.conversations:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The query the channels were searched with.",
+				Computed:            true,
+			},
+			"query": schema.StringAttribute{
+				MarkdownDescription: "Text the channel names start with. Matches every channel when unset.",
+				Optional:            true,
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only match channels whose name matches this regular expression, such as `^incident-[0-9]+$`. " +
+					"Slack cannot search by regular expression, so it is applied to each page of channels `query` matched, " +
+					"and batches cut by `max_results` can have fewer channels.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the workspaces to search in. Org-level tokens search the whole organization when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"connected_team_ids": schema.SetAttribute{
+				MarkdownDescription: "Only match channels shared with these workspaces or organizations.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"search_channel_types": schema.SetAttribute{
+				MarkdownDescription: "Only match channels of these types, such as `private`, `archived`, `exclude_archived`, `external_shared`, `org_wide` or `multi_workspace`.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(stringvalidator.OneOf(
+						"private", "private_exclude", "archived", "exclude_archived",
+						"external_shared", "exclude_external_shared", "multi_workspace",
+						"org_wide", "external_shared_exclude", "exclude_org_shared",
+					)),
+				},
+			},
+			"max_results": schema.Int64Attribute{
+				MarkdownDescription: "Stop searching once this many channels matched, so organizations with too many channels to keep in state " +
+					"can be processed a batch at a time. Pass `next_cursor` as `cursor` to continue with the next batch. Matches every channel when unset.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"cursor": schema.StringAttribute{
+				MarkdownDescription: "The `next_cursor` of an earlier search with the same arguments, to continue where it stopped.",
+				Optional:            true,
+			},
+			"next_cursor": schema.StringAttribute{
+				MarkdownDescription: "The cursor to continue the search from when `max_results` stopped it before every channel was matched, or `\"\"` when none are left.",
+				Computed:            true,
+			},
+			"channel_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the matching channels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"channels": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each matching channel.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The channel's ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The channel's name.",
+							Computed:            true,
+						},
+						"is_private": schema.BoolAttribute{
+							MarkdownDescription: "Whether the channel is private.",
+							Computed:            true,
+						},
+						"is_archived": schema.BoolAttribute{
+							MarkdownDescription: "Whether the channel is archived.",
+							Computed:            true,
+						},
+						"is_ext_shared": schema.BoolAttribute{
+							MarkdownDescription: "Whether the channel is shared with another organization.",
+							Computed:            true,
+						},
+						"member_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of members of the channel.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdminConversationsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_admin_conversations")...)
+}
+
+func (d *AdminConversationsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data AdminConversationsDataSourceModel
+	client := d.client
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teamIds, connectedTeamIds, searchChannelTypes []string
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &teamIds, false)...)
+	resp.Diagnostics.Append(data.ConnectedTeamIds.ElementsAs(ctx, &connectedTeamIds, false)...)
+	resp.Diagnostics.Append(data.SearchChannelTypes.ElementsAs(ctx, &searchChannelTypes, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			return
+		}
+	}
+
+	options := []slack.AdminConversationsSearchOption{
+		slack.AdminConversationsSearchOptionQuery(data.Query.ValueString()),
+	}
+	if len(teamIds) > 0 {
+		options = append(options, slack.AdminConversationsSearchOptionTeamIDs(teamIds))
+	}
+	if len(connectedTeamIds) > 0 {
+		options = append(options, slack.AdminConversationsSearchOptionConnectedTeamIDs(connectedTeamIds))
+	}
+	if len(searchChannelTypes) > 0 {
+		options = append(options, slack.AdminConversationsSearchOptionSearchChannelTypes(searchChannelTypes))
+	}
+
+	conversations, cursor, err := searchAdminConversations(ctx, client, data.Cursor.ValueString(), int(data.MaxResults.ValueInt64()), options...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search channels, got error: %s", err))
+		return
+	}
+
+	channelIds := []string{}
+	channels := []AdminConversationModel{}
+
+	for _, conversation := range conversations {
+		if nameRegex != nil && !nameRegex.MatchString(conversation.Name) {
+			continue
+		}
+
+		channelIds = append(channelIds, conversation.ID)
+		channels = append(channels, AdminConversationModel{
+			Id:          types.StringValue(conversation.ID),
+			Name:        types.StringValue(conversation.Name),
+			IsPrivate:   types.BoolValue(conversation.IsPrivate),
+			IsArchived:  types.BoolValue(conversation.IsArchived),
+			IsExtShared: types.BoolValue(conversation.IsExtShared),
+			MemberCount: types.Int64Value(int64(conversation.MemberCount)),
+		})
+	}
+
+	// Set data from API response.
+	data.Id = types.StringValue(data.Query.ValueString())
+	data.NextCursor = types.StringValue(cursor)
+
+	channelIdsValue, diags := types.ListValueFrom(ctx, types.StringType, channelIds)
+	resp.Diagnostics.Append(diags...)
+	data.ChannelIds = channelIdsValue
+
+	channelsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: adminConversationAttrTypes}, channels)
+	resp.Diagnostics.Append(diags...)
+	data.Channels = channelsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// searchAdminConversations pages through admin.conversations.search from
+// cursor, returning the matching channels and the cursor to continue from, or
+// "" when none are left. It stops once maxResults channels matched; a
+// maxResults of zero matches every channel.
+func searchAdminConversations(ctx context.Context, client *SlackClient, cursor string, maxResults int, options ...slack.AdminConversationsSearchOption) ([]slack.AdminConversation, string, error) {
+	var conversations []slack.AdminConversation
+
+	for {
+		// Pages are never cut short, so the search can continue from the
+		// cursor of the last page read.
+		limit := adminConversationsPageLimit
+		if maxResults > 0 {
+			limit = min(limit, maxResults-len(conversations))
+		}
+
+		pageOptions := append(slices.Clone(options),
+			slack.AdminConversationsSearchOptionCursor(cursor),
+			slack.AdminConversationsSearchOptionLimit(limit),
+		)
+
+		var response *slack.AdminConversationsSearchResponse
+
+		err := client.retry(ctx, "admin.conversations.search", func() (err error) {
+			response, err = client.AdminConversationsSearch(ctx, pageOptions...)
+			return err
+		})
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		conversations = append(conversations, response.Conversations...)
+
+		cursor = response.NextCursor
+		if cursor == "" || (maxResults > 0 && len(conversations) >= maxResults) {
+			return conversations, cursor, nil
+		}
+	}
+}
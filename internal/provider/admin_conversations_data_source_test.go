@@ -0,0 +1,82 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminConversationsDataSource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvChannelId)
+	channelName := testAccFixture(t, testEnvChannelName)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_admin_conversations" "test" {
+  query = "` + channelName + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_admin_conversations.test", "id", channelName),
+					resource.TestCheckTypeSetElemAttr("data.slack_admin_conversations.test", "channel_ids.*", channelId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_admin_conversations.test", "channels.*", map[string]string{
+						"id":   channelId,
+						"name": channelName,
+					}),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "slack_admin_conversations" "first" {
+  query       = "` + channelName + `"
+  max_results = 1
+}
+
+data "slack_admin_conversations" "rest" {
+  query  = "` + channelName + `"
+  cursor = data.slack_admin_conversations.first.next_cursor
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_admin_conversations.first", "channel_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.slack_admin_conversations.rest", "next_cursor", ""),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "slack_admin_conversations" "regex" {
+  name_regex = "^` + regexp.QuoteMeta(channelName) + `$"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_admin_conversations.regex", "channel_ids.#", "1"),
+					resource.TestCheckResourceAttr("data.slack_admin_conversations.regex", "channel_ids.0", channelId),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "slack_admin_conversations" "invalid_regex" {
+  name_regex = "incident-("
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+			{
+				Config: providerConfig + `
+data "slack_admin_conversations" "invalid" {
+  search_channel_types = ["public"]
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
@@ -0,0 +1,211 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AdminUsersDataSource{}
+	_ datasource.DataSourceWithConfigure = &AdminUsersDataSource{}
+)
+
+func NewAdminUsersDataSource() datasource.DataSource {
+	return &AdminUsersDataSource{}
+}
+
+// AdminUsersDataSource defines the data source implementation.
+type AdminUsersDataSource struct {
+	client *SlackClient
+}
+
+// AdminUsersDataSourceModel describes the data source data model.
+type AdminUsersDataSourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+	Users  types.List   `tfsdk:"users"`
+}
+
+// AdminUserModel describes a single entry of users.
+type AdminUserModel struct {
+	Id                types.String `tfsdk:"id"`
+	Email             types.String `tfsdk:"email"`
+	Has2fa            types.Bool   `tfsdk:"has_2fa"`
+	IsAdmin           types.Bool   `tfsdk:"is_admin"`
+	IsOwner           types.Bool   `tfsdk:"is_owner"`
+	IsPrimaryOwner    types.Bool   `tfsdk:"is_primary_owner"`
+	IsRestricted      types.Bool   `tfsdk:"is_restricted"`
+	IsUltraRestricted types.Bool   `tfsdk:"is_ultra_restricted"`
+	IsBot             types.Bool   `tfsdk:"is_bot"`
+	ExpirationTs      types.Int64  `tfsdk:"expiration_ts"`
+}
+
+var adminUserAttrTypes = map[string]attr.Type{
+	"id":                  types.StringType,
+	"email":               types.StringType,
+	"has_2fa":             types.BoolType,
+	"is_admin":            types.BoolType,
+	"is_owner":            types.BoolType,
+	"is_primary_owner":    types.BoolType,
+	"is_restricted":       types.BoolType,
+	"is_ultra_restricted": types.BoolType,
+	"is_bot":              types.BoolType,
+	"expiration_ts":       types.Int64Type,
+}
+
+func (d *AdminUsersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_admin_users"
+}
+
+func (d *AdminUsersDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the members of a workspace with their roles and two-factor authentication status, for security reviews and role reconciliation.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+- ` + "`admin.users:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the users were read from.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to list users of. Defaults to the provider's workspace; org-level tokens need it set.",
+				Optional:            true,
+			},
+			"users": schema.ListNestedAttribute{
+				MarkdownDescription: "The members of the workspace.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The user's ID.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The user's email address.",
+							Computed:            true,
+						},
+						"has_2fa": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user has two-factor authentication enabled.",
+							Computed:            true,
+						},
+						"is_admin": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is an admin of the workspace.",
+							Computed:            true,
+						},
+						"is_owner": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is an owner of the workspace.",
+							Computed:            true,
+						},
+						"is_primary_owner": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is the primary owner of the workspace.",
+							Computed:            true,
+						},
+						"is_restricted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is a multi-channel guest.",
+							Computed:            true,
+						},
+						"is_ultra_restricted": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is a single-channel guest.",
+							Computed:            true,
+						},
+						"is_bot": schema.BoolAttribute{
+							MarkdownDescription: "Whether the user is a bot.",
+							Computed:            true,
+						},
+						"expiration_ts": schema.Int64Attribute{
+							MarkdownDescription: "When a guest's account expires, as a Unix timestamp, or 0 if it does not.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AdminUsersDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_admin_users")...)
+}
+
+func (d *AdminUsersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data AdminUsersDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamId := data.TeamId.ValueString()
+	if teamId == "" {
+		teamId = d.client.teamId
+	}
+
+	users := []AdminUserModel{}
+
+	err := listAdminUsers(ctx, d.client, teamId, func(user adminUser) bool {
+		users = append(users, AdminUserModel{
+			Id:                types.StringValue(user.ID),
+			Email:             d.client.emailValue(user.Email),
+			Has2fa:            types.BoolValue(user.Has2fa),
+			IsAdmin:           types.BoolValue(user.IsAdmin),
+			IsOwner:           types.BoolValue(user.IsOwner),
+			IsPrimaryOwner:    types.BoolValue(user.IsPrimaryOwner),
+			IsRestricted:      types.BoolValue(user.IsRestricted),
+			IsUltraRestricted: types.BoolValue(user.IsUltraRestricted),
+			IsBot:             types.BoolValue(user.IsBot),
+			ExpirationTs:      types.Int64Value(user.ExpirationTs),
+		})
+		return false
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users of workspace: %s, got error: %s", teamId, err))
+		return
+	}
+
+	// Set data from API response.
+	data.Id = types.StringValue(teamId)
+
+	usersValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: adminUserAttrTypes}, users)
+	resp.Diagnostics.Append(diags...)
+	data.Users = usersValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,37 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAdminUsersDataSource(t *testing.T) {
+	userId := testAccFixture(t, testEnvUserId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccAdminUsersDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_admin_users.test", "id"),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_admin_users.test", "users.*", map[string]string{
+						"id":       userId,
+						"is_admin": "true",
+						"has_2fa":  "true",
+					}),
+				),
+			},
+		},
+	})
+}
+
+const testAccAdminUsersDataSourceConfig = `
+data "slack_admin_users" "test" {
+}
+`
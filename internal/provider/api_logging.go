@@ -0,0 +1,103 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// apiLogFields maps the Slack API parameters identifying the objects a call
+// acts on to the log fields they are recorded as.
+var apiLogFields = map[string]string{
+	"channel":    "channel_id",
+	"channel_id": "channel_id",
+	"user":       "user_id",
+	"user_id":    "user_id",
+	"users":      "user_ids",
+	"usergroup":  "usergroup_id",
+	"team_id":    "team_id",
+	"app_id":     "app_id",
+	"file":       "file_id",
+}
+
+// loggingTransport logs every request made to the Slack API, with the method
+// called, the IDs it acts on, its duration, status and Slack's request ID, to
+// the logger of the request's context.
+type loggingTransport struct {
+	next http.RoundTripper
+}
+
+func newLoggingTransport(next http.RoundTripper) http.RoundTripper {
+	if next == nil {
+		next = http.DefaultTransport
+	}
+	return &loggingTransport{next: next}
+}
+
+func (t *loggingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	ctx := req.Context()
+
+	fields := map[string]any{
+		"endpoint": path.Base(req.URL.Path),
+	}
+	for parameter, value := range requestParameters(req) {
+		if field, ok := apiLogFields[parameter]; ok && value[0] != "" {
+			fields[field] = value[0]
+		}
+	}
+
+	start := time.Now()
+	resp, err := t.next.RoundTrip(req)
+	fields["duration_ms"] = time.Since(start).Milliseconds()
+
+	if err != nil {
+		fields["error"] = err.Error()
+		tflog.Debug(ctx, "Slack API request failed", fields)
+		return resp, err
+	}
+
+	fields["status"] = resp.StatusCode
+	if requestId := resp.Header.Get("X-Slack-Req-Id"); requestId != "" {
+		fields["slack_request_id"] = requestId
+	}
+	tflog.Debug(ctx, "Slack API request", fields)
+
+	return resp, err
+}
+
+// requestParameters returns the query and form parameters of req, leaving its
+// body to be sent.
+func requestParameters(req *http.Request) url.Values {
+	parameters := req.URL.Query()
+
+	if req.GetBody == nil || req.Header.Get("Content-Type") != "application/x-www-form-urlencoded" {
+		return parameters
+	}
+
+	body, err := req.GetBody()
+	if err != nil {
+		return parameters
+	}
+	defer body.Close()
+
+	encoded, err := io.ReadAll(body)
+	if err != nil {
+		return parameters
+	}
+	form, err := url.ParseQuery(string(encoded))
+	if err != nil {
+		return parameters
+	}
+	for parameter, value := range form {
+		parameters[parameter] = value
+	}
+
+	return parameters
+}
@@ -0,0 +1,63 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-log/tflogtest"
+)
+
+func TestLoggingTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-Slack-Req-Id", "req-123")
+		_, _ = w.Write([]byte(`{"ok":true}`))
+	}))
+	defer server.Close()
+
+	var output bytes.Buffer
+	ctx := tflogtest.RootLogger(context.Background(), &output)
+
+	values := url.Values{"channel": {"C0123"}, "text": {"hello"}}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, server.URL+"/api/chat.postMessage", strings.NewReader(values.Encode()))
+	if err != nil {
+		t.Fatal(err)
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+
+	client := &http.Client{Transport: newLoggingTransport(nil)}
+	resp, err := client.Do(req)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	entries, err := tflogtest.MultilineJSONDecode(&output)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(entries) != 1 {
+		t.Fatalf("expected a single log entry, got: %v", entries)
+	}
+
+	for field, expected := range map[string]any{
+		"endpoint":         "chat.postMessage",
+		"channel_id":       "C0123",
+		"status":           float64(http.StatusOK),
+		"slack_request_id": "req-123",
+	} {
+		if entries[0][field] != expected {
+			t.Errorf("expected %s to be %v, got: %v", field, expected, entries[0][field])
+		}
+	}
+	if _, ok := entries[0]["text"]; ok {
+		t.Error("expected message text to be left out of the log")
+	}
+}
@@ -0,0 +1,150 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/url"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AppManifestDataSource{}
+	_ datasource.DataSourceWithConfigure = &AppManifestDataSource{}
+)
+
+func NewAppManifestDataSource() datasource.DataSource {
+	return &AppManifestDataSource{}
+}
+
+// AppManifestDataSource defines the data source implementation.
+type AppManifestDataSource struct {
+	client *SlackClient
+}
+
+// AppManifestDataSourceModel describes the data source data model.
+type AppManifestDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	AppId    types.String `tfsdk:"app_id"`
+	Token    types.String `tfsdk:"token"`
+	Manifest types.String `tfsdk:"manifest"`
+}
+
+// appsManifestExportResponse keeps the manifest as Slack returns it, rather
+// than decoding it into slack.Manifest, which leaves out fields it does not
+// know of.
+type appsManifestExportResponse struct {
+	slack.SlackResponse
+	Manifest json.RawMessage `json:"manifest"`
+}
+
+func (d *AppManifestDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_manifest"
+}
+
+func (d *AppManifestDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Exports the manifest of an app with ` + "`apps.manifest.export`" + `, to compare existing apps against or adopt them.
+### Required Permissions
+- An app configuration token of a collaborator on the app, either as ` + "`token`" + ` or as the provider's token.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the app.",
+				Computed:            true,
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the app to export the manifest of.",
+				Required:            true,
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The app configuration token to export the manifest with. Defaults to the provider's token. " +
+					"App configuration tokens expire after 12 hours, so pass one from a variable rather than saving it in configuration.",
+				Optional:  true,
+				Sensitive: true,
+			},
+			"manifest": schema.StringAttribute{
+				MarkdownDescription: "The app's manifest, as JSON. Decode it with `jsondecode`.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *AppManifestDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *AppManifestDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data AppManifestDataSourceModel
+	client := d.client
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	token := client.token
+	if data.Token.ValueString() != "" {
+		token = data.Token.ValueString()
+	}
+
+	var response appsManifestExportResponse
+
+	err := client.retry(ctx, "apps.manifest.export", func() error {
+		return client.apiCallWithToken(ctx, token, "apps.manifest.export", url.Values{
+			"app_id": {data.AppId.ValueString()},
+		}, &response)
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to export manifest of app: %s, got error: %s", data.AppId.ValueString(), err))
+		return
+	}
+
+	var manifest bytes.Buffer
+	if err := json.Compact(&manifest, response.Manifest); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read manifest of app: %s, got error: %s", data.AppId.ValueString(), err))
+		return
+	}
+
+	// Set data from API response.
+	data.Id = data.AppId
+	data.Manifest = types.StringValue(manifest.String())
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
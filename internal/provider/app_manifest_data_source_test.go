@@ -0,0 +1,44 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAppManifestDataSource(t *testing.T) {
+	appId := testAccFixture(t, testEnvManifestAppId)
+	token := testAccFixture(t, testEnvConfigToken)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccAppManifestDataSourceConfig(appId, token),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_app_manifest.test", "id", appId),
+					resource.TestCheckResourceAttrSet("data.slack_app_manifest.test", "manifest"),
+					resource.TestMatchResourceAttr("data.slack_app_manifest.test", "manifest", regexp.MustCompile(`"display_information":`)),
+				),
+			},
+			{
+				Config:      providerConfig + testAccAppManifestDataSourceConfig("ADOESNOTEXIST", token),
+				ExpectError: regexp.MustCompile(`Unable to export manifest`),
+			},
+		},
+	})
+}
+
+func testAccAppManifestDataSourceConfig(appId string, token string) string {
+	return `
+data "slack_app_manifest" "test" {
+  app_id = "` + appId + `"
+  token  = "` + token + `"
+}
+`
+}
@@ -0,0 +1,298 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &AppRequestsDataSource{}
+	_ datasource.DataSourceWithConfigure = &AppRequestsDataSource{}
+)
+
+func NewAppRequestsDataSource() datasource.DataSource {
+	return &AppRequestsDataSource{}
+}
+
+// AppRequestsDataSource defines the data source implementation.
+type AppRequestsDataSource struct {
+	client *SlackClient
+}
+
+// AppRequestsDataSourceModel describes the data source data model.
+type AppRequestsDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	TeamId       types.String `tfsdk:"team_id"`
+	EnterpriseId types.String `tfsdk:"enterprise_id"`
+	AppIds       types.Set    `tfsdk:"app_ids"`
+	Requests     types.List   `tfsdk:"requests"`
+}
+
+// AppRequestModel describes a single entry of requests.
+type AppRequestModel struct {
+	Id          types.String `tfsdk:"id"`
+	AppId       types.String `tfsdk:"app_id"`
+	AppName     types.String `tfsdk:"app_name"`
+	UserId      types.String `tfsdk:"user_id"`
+	TeamId      types.String `tfsdk:"team_id"`
+	Scopes      types.List   `tfsdk:"scopes"`
+	Message     types.String `tfsdk:"message"`
+	DateCreated types.Int64  `tfsdk:"date_created"`
+}
+
+var appRequestAttrTypes = map[string]attr.Type{
+	"id":           types.StringType,
+	"app_id":       types.StringType,
+	"app_name":     types.StringType,
+	"user_id":      types.StringType,
+	"team_id":      types.StringType,
+	"scopes":       types.ListType{ElemType: types.StringType},
+	"message":      types.StringType,
+	"date_created": types.Int64Type,
+}
+
+// appRequest is a request to install an app as listed by
+// admin.apps.requests.list.
+type appRequest struct {
+	ID  string `json:"id"`
+	App struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"app"`
+	User struct {
+		ID string `json:"id"`
+	} `json:"user"`
+	Team struct {
+		ID string `json:"id"`
+	} `json:"team"`
+	Scopes []struct {
+		Name string `json:"name"`
+	} `json:"scopes"`
+	Message     string `json:"message"`
+	DateCreated int64  `json:"date_created"`
+}
+
+// appRequestsListResponse is the response of admin.apps.requests.list.
+type appRequestsListResponse struct {
+	slack.SlackResponse
+	AppRequests      []appRequest `json:"app_requests"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+func (d *AppRequestsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_requests"
+}
+
+func (d *AppRequestsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the pending requests of users to install apps in a workspace, or across an Enterprise Grid organization.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+- ` + "`admin.apps:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace or organization the requests were read from.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to list app requests of. Org-level tokens need one of `team_id` and `enterprise_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("enterprise_id")),
+				},
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization to list app requests of.",
+				Optional:            true,
+			},
+			"app_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of the IDs of the requested apps.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"requests": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each pending request.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The request's ID.",
+							Computed:            true,
+						},
+						"app_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the requested app.",
+							Computed:            true,
+						},
+						"app_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the requested app.",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user who requested the app.",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the workspace the app was requested for.",
+							Computed:            true,
+						},
+						"scopes": schema.ListAttribute{
+							MarkdownDescription: "The scopes the app requested.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"message": schema.StringAttribute{
+							MarkdownDescription: "The message the user left with the request.",
+							Computed:            true,
+						},
+						"date_created": schema.Int64Attribute{
+							MarkdownDescription: "When the app was requested, as a Unix timestamp.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *AppRequestsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_app_requests")...)
+}
+
+func (d *AppRequestsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data AppRequestsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	appRequests, err := listAppRequests(ctx, d.client, data.TeamId.ValueString(), data.EnterpriseId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list app requests, got error: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	appIds := []string{}
+	requests := make([]AppRequestModel, 0, len(appRequests))
+
+	for _, appRequest := range appRequests {
+		scopes := make([]string, 0, len(appRequest.Scopes))
+		for _, scope := range appRequest.Scopes {
+			scopes = append(scopes, scope.Name)
+		}
+
+		scopesValue, scopeDiags := types.ListValueFrom(ctx, types.StringType, scopes)
+		resp.Diagnostics.Append(scopeDiags...)
+
+		appIds = append(appIds, appRequest.App.ID)
+		requests = append(requests, AppRequestModel{
+			Id:          types.StringValue(appRequest.ID),
+			AppId:       types.StringValue(appRequest.App.ID),
+			AppName:     types.StringValue(appRequest.App.Name),
+			UserId:      types.StringValue(appRequest.User.ID),
+			TeamId:      types.StringValue(appRequest.Team.ID),
+			Scopes:      scopesValue,
+			Message:     types.StringValue(appRequest.Message),
+			DateCreated: types.Int64Value(appRequest.DateCreated),
+		})
+	}
+
+	// Set data from API response.
+	id := data.TeamId.ValueString() + data.EnterpriseId.ValueString()
+	if id == "" {
+		id = d.client.teamId
+	}
+	data.Id = types.StringValue(id)
+
+	data.AppIds, diags = types.SetValueFrom(ctx, types.StringType, appIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.Requests, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: appRequestAttrTypes}, requests)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAppRequests pages through admin.apps.requests.list for a workspace or,
+// when teamId is empty, an organization.
+func listAppRequests(ctx context.Context, client *SlackClient, teamId string, enterpriseId string) ([]appRequest, error) {
+	var appRequests []appRequest
+	cursor := ""
+
+	for {
+		values := url.Values{
+			"cursor": {cursor},
+			"limit":  {"100"},
+		}
+		if teamId != "" {
+			values.Set("team_id", teamId)
+		} else if enterpriseId != "" {
+			values.Set("enterprise_id", enterpriseId)
+		}
+
+		var response appRequestsListResponse
+
+		err := client.retry(ctx, "admin.apps.requests.list", func() error {
+			return client.apiCall(ctx, "admin.apps.requests.list", values, &response)
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		appRequests = append(appRequests, response.AppRequests...)
+
+		cursor = response.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return appRequests, nil
+		}
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAppRequestsDataSource(t *testing.T) {
+	appId := testAccFixture(t, testEnvRequestedAppId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccAppRequestsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_app_requests.test", "id"),
+					resource.TestCheckTypeSetElemAttr("data.slack_app_requests.test", "app_ids.*", appId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_app_requests.test", "requests.*", map[string]string{
+						"app_id": appId,
+					}),
+				),
+			},
+			{
+				Config:      providerConfig + testAccAppRequestsDataSourceConfigConflicting,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+const testAccAppRequestsDataSourceConfig = `
+data "slack_app_requests" "test" {
+}
+`
+
+const testAccAppRequestsDataSourceConfigConflicting = `
+data "slack_app_requests" "conflicting" {
+  team_id       = "T0123456789"
+  enterprise_id = "E0123456789"
+}
+`
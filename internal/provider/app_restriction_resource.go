@@ -0,0 +1,357 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &AppRestrictionResource{}
+var _ resource.ResourceWithImportState = &AppRestrictionResource{}
+
+func NewAppRestrictionResource() resource.Resource {
+	return &AppRestrictionResource{}
+}
+
+// AppRestrictionResource defines the resource implementation.
+type AppRestrictionResource struct {
+	client *SlackClient
+}
+
+// AppRestrictionResourceModel describes the resource data model.
+type AppRestrictionResourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	AppId        types.String `tfsdk:"app_id"`
+	TeamId       types.String `tfsdk:"team_id"`
+	EnterpriseId types.String `tfsdk:"enterprise_id"`
+	AppName      types.String `tfsdk:"app_name"`
+}
+
+// adminApp is an app as listed by admin.apps.approved.list and
+// admin.apps.restricted.list.
+type adminApp struct {
+	App struct {
+		ID          string `json:"id"`
+		Name        string `json:"name"`
+		Description string `json:"description"`
+	} `json:"app"`
+	Scopes []struct {
+		Name string `json:"name"`
+	} `json:"scopes"`
+	DateUpdated    int64 `json:"date_updated"`
+	LastResolvedBy struct {
+		ActorId string `json:"actor_id"`
+	} `json:"last_resolved_by"`
+}
+
+// adminAppsListResponse is the response of admin.apps.approved.list and
+// admin.apps.restricted.list.
+type adminAppsListResponse struct {
+	slack.SlackResponse
+	ApprovedApps     []adminApp `json:"approved_apps"`
+	RestrictedApps   []adminApp `json:"restricted_apps"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+func (r *AppRestrictionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_app_restriction"
+}
+
+func (r *AppRestrictionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Restricts an app from being installed in a workspace, or across an Enterprise Grid organization.
+Destroying the resource clears the restriction, so the app can be requested again.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+` + "- `admin.apps:read`" + `
+` + "- `admin.apps:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the restriction, in the form `team_id:app_id` or `enterprise_id:app_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"app_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the app to restrict.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to restrict the app in. Exactly one of `team_id` and `enterprise_id` must be set.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.ExactlyOneOf(path.MatchRoot("enterprise_id")),
+				},
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the organization to restrict the app across.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"app_name": schema.StringAttribute{
+				MarkdownDescription: "Name of the app.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *AppRestrictionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_app_restriction")...)
+}
+
+// appResolutionValues returns the parameters that scope an admin.apps call to
+// data's workspace or organization.
+func appResolutionValues(data AppRestrictionResourceModel) url.Values {
+	values := url.Values{
+		"app_id": {data.AppId.ValueString()},
+	}
+	if !data.TeamId.IsNull() {
+		values.Set("team_id", data.TeamId.ValueString())
+	} else {
+		values.Set("enterprise_id", data.EnterpriseId.ValueString())
+	}
+	return values
+}
+
+func (r *AppRestrictionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data AppRestrictionResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "admin.apps.restrict", func() error {
+		return client.apiCall(ctx, "admin.apps.restrict", appResolutionValues(data), &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to restrict app: %s, got error: %s", data.AppId.ValueString(), err))
+		return
+	}
+
+	app, err := getAdminApp(ctx, client, "admin.apps.restricted.list", data.TeamId.ValueString(), data.EnterpriseId.ValueString(), data.AppId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restricted app: %s, got error: %s", data.AppId.ValueString(), err))
+		return
+	}
+
+	data.Id = types.StringValue(data.TeamId.ValueString() + data.EnterpriseId.ValueString() + ":" + data.AppId.ValueString())
+	data.AppName = types.StringValue(app.App.Name)
+
+	tflog.Trace(ctx, "Restricted a slack app", map[string]any{"app_id": data.AppId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppRestrictionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data AppRestrictionResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	app, err := getAdminApp(ctx, client, "admin.apps.restricted.list", data.TeamId.ValueString(), data.EnterpriseId.ValueString(), data.AppId.ValueString())
+
+	if err != nil {
+		if err.Error() == "app_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read restricted apps, got error: %s", err))
+		return
+	}
+
+	data.AppName = types.StringValue(app.App.Name)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *AppRestrictionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing
+	// to update.
+	var plan AppRestrictionResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *AppRestrictionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data AppRestrictionResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "admin.apps.clearResolution", func() error {
+		return client.apiCall(ctx, "admin.apps.clearResolution", appResolutionValues(data), &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear restriction of app, got error: %s", err))
+		return
+	}
+}
+
+func (r *AppRestrictionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	scopeId, appId, ok := strings.Cut(req.ID, ":")
+
+	if !ok || scopeId == "" || appId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_id:app_id or enterprise_id:app_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	// Organization IDs start with E, workspace IDs with T.
+	scope := "team_id"
+	if strings.HasPrefix(scopeId, "E") {
+		scope = "enterprise_id"
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("app_id"), appId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(scope), scopeId)...)
+}
+
+// listAdminApps pages through method, one of admin.apps.approved.list and
+// admin.apps.restricted.list, for a workspace or, when teamId is empty, an
+// organization. Listing stops early once found returns true.
+func listAdminApps(ctx context.Context, client *SlackClient, method string, teamId string, enterpriseId string, found func(app adminApp) bool) error {
+	cursor := ""
+
+	for {
+		values := url.Values{
+			"cursor": {cursor},
+			"limit":  {"100"},
+		}
+		if teamId != "" {
+			values.Set("team_id", teamId)
+		} else if enterpriseId != "" {
+			values.Set("enterprise_id", enterpriseId)
+		}
+
+		var response adminAppsListResponse
+
+		err := client.retry(ctx, method, func() error {
+			return client.apiCall(ctx, method, values, &response)
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, app := range append(response.ApprovedApps, response.RestrictedApps...) {
+			if found(app) {
+				return nil
+			}
+		}
+
+		cursor = response.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return nil
+		}
+	}
+}
+
+// getAdminApp finds an app among those listed by method, see listAdminApps.
+func getAdminApp(ctx context.Context, client *SlackClient, method string, teamId string, enterpriseId string, appId string) (*adminApp, error) {
+	var match *adminApp
+
+	err := listAdminApps(ctx, client, method, teamId, enterpriseId, func(app adminApp) bool {
+		if app.App.ID == appId {
+			match = &app
+		}
+		return match != nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return nil, fmt.Errorf("app_not_found")
+	}
+	return match, nil
+}
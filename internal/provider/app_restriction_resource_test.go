@@ -0,0 +1,41 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccAppRestrictionResource(t *testing.T) {
+	teamId := testAccFixture(t, testEnvAssignTeamId)
+	appId := testAccFixture(t, testEnvAppId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_app_restriction" "test" {
+  app_id  = "` + appId + `"
+  team_id = "` + teamId + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_app_restriction.test", "id", teamId+":"+appId),
+					resource.TestCheckResourceAttrSet("slack_app_restriction.test", "app_name"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_app_restriction.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
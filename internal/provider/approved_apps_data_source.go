@@ -0,0 +1,216 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ApprovedAppsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ApprovedAppsDataSource{}
+)
+
+func NewApprovedAppsDataSource() datasource.DataSource {
+	return &ApprovedAppsDataSource{}
+}
+
+// ApprovedAppsDataSource defines the data source implementation.
+type ApprovedAppsDataSource struct {
+	client *SlackClient
+}
+
+// ApprovedAppsDataSourceModel describes the data source data model.
+type ApprovedAppsDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	TeamId       types.String `tfsdk:"team_id"`
+	EnterpriseId types.String `tfsdk:"enterprise_id"`
+	AppIds       types.Set    `tfsdk:"app_ids"`
+	Apps         types.List   `tfsdk:"apps"`
+}
+
+// ApprovedAppModel describes a single entry of apps.
+type ApprovedAppModel struct {
+	Id             types.String `tfsdk:"id"`
+	Name           types.String `tfsdk:"name"`
+	Description    types.String `tfsdk:"description"`
+	Scopes         types.List   `tfsdk:"scopes"`
+	DateUpdated    types.Int64  `tfsdk:"date_updated"`
+	LastResolvedBy types.String `tfsdk:"last_resolved_by"`
+}
+
+var approvedAppAttrTypes = map[string]attr.Type{
+	"id":               types.StringType,
+	"name":             types.StringType,
+	"description":      types.StringType,
+	"scopes":           types.ListType{ElemType: types.StringType},
+	"date_updated":     types.Int64Type,
+	"last_resolved_by": types.StringType,
+}
+
+func (d *ApprovedAppsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_approved_apps"
+}
+
+func (d *ApprovedAppsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the apps approved for installation in a workspace, or across an Enterprise Grid organization.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+- ` + "`admin.apps:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace or organization the apps were read from.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to list approved apps of. Org-level tokens need one of `team_id` and `enterprise_id`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.MatchRoot("enterprise_id")),
+				},
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization to list approved apps of.",
+				Optional:            true,
+			},
+			"app_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of the approved apps' IDs.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"apps": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each approved app.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The app's ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The app's name.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "The app's description.",
+							Computed:            true,
+						},
+						"scopes": schema.ListAttribute{
+							MarkdownDescription: "The scopes the app was approved with.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"date_updated": schema.Int64Attribute{
+							MarkdownDescription: "When the app was last approved, as a Unix timestamp.",
+							Computed:            true,
+						},
+						"last_resolved_by": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user who last approved the app.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ApprovedAppsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_approved_apps")...)
+}
+
+func (d *ApprovedAppsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ApprovedAppsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var appIds []string
+	var apps []ApprovedAppModel
+	var diags diag.Diagnostics
+
+	err := listAdminApps(ctx, d.client, "admin.apps.approved.list", data.TeamId.ValueString(), data.EnterpriseId.ValueString(), func(app adminApp) bool {
+		scopes := make([]string, 0, len(app.Scopes))
+		for _, scope := range app.Scopes {
+			scopes = append(scopes, scope.Name)
+		}
+
+		scopesValue, scopeDiags := types.ListValueFrom(ctx, types.StringType, scopes)
+		diags.Append(scopeDiags...)
+
+		appIds = append(appIds, app.App.ID)
+		apps = append(apps, ApprovedAppModel{
+			Id:             types.StringValue(app.App.ID),
+			Name:           types.StringValue(app.App.Name),
+			Description:    types.StringValue(app.App.Description),
+			Scopes:         scopesValue,
+			DateUpdated:    types.Int64Value(app.DateUpdated),
+			LastResolvedBy: types.StringValue(app.LastResolvedBy.ActorId),
+		})
+		return false
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list approved apps, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(diags...)
+
+	// Set data from API response.
+	id := data.TeamId.ValueString() + data.EnterpriseId.ValueString()
+	if id == "" {
+		id = d.client.teamId
+	}
+	data.Id = types.StringValue(id)
+
+	data.AppIds, diags = types.SetValueFrom(ctx, types.StringType, appIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.Apps, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: approvedAppAttrTypes}, apps)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccApprovedAppsDataSource(t *testing.T) {
+	appId := testAccFixture(t, testEnvApprovedAppId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccApprovedAppsDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_approved_apps.test", "id"),
+					resource.TestCheckTypeSetElemAttr("data.slack_approved_apps.test", "app_ids.*", appId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_approved_apps.test", "apps.*", map[string]string{
+						"id": appId,
+					}),
+				),
+			},
+			{
+				Config:      providerConfig + testAccApprovedAppsDataSourceConfigConflicting,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+const testAccApprovedAppsDataSourceConfig = `
+data "slack_approved_apps" "test" {
+}
+`
+
+const testAccApprovedAppsDataSourceConfigConflicting = `
+data "slack_approved_apps" "conflicting" {
+  team_id       = "T0123456789"
+  enterprise_id = "E0123456789"
+}
+`
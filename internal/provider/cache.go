@@ -0,0 +1,140 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// readCache caches the workspace-wide listings data sources search, such as
+// every channel or user, on disk, so they are not listed again by every
+// Terraform run within ttl.
+type readCache struct {
+	dir string
+	ttl time.Duration
+
+	// teamId keeps the listings of different workspaces apart.
+	teamId string
+}
+
+type readCacheEntry struct {
+	CachedAt time.Time       `json:"cached_at"`
+	Value    json.RawMessage `json:"value"`
+}
+
+// defaultCacheDir returns the directory the read cache is kept in when none
+// is configured.
+func defaultCacheDir() (string, error) {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(dir, "terraform-provider-slack"), nil
+}
+
+func (c *readCache) path(key string) string {
+	sum := sha256.Sum256([]byte(c.teamId + "/" + key))
+	return filepath.Join(c.dir, hex.EncodeToString(sum[:])+".json")
+}
+
+// get decodes the value cached under key into value, reporting whether there
+// was one that has not expired. A nil cache never has a value.
+func (c *readCache) get(ctx context.Context, key string, value any) bool {
+	if c == nil {
+		return false
+	}
+
+	data, err := os.ReadFile(c.path(key))
+	if err != nil {
+		return false
+	}
+
+	var entry readCacheEntry
+	if err := json.Unmarshal(data, &entry); err != nil || time.Since(entry.CachedAt) > c.ttl {
+		return false
+	}
+	if err := json.Unmarshal(entry.Value, value); err != nil {
+		return false
+	}
+
+	tflog.Debug(ctx, "Using cached Slack API response", map[string]any{
+		"cache_key": key,
+		"cached_at": entry.CachedAt.Format(time.RFC3339),
+	})
+	return true
+}
+
+// put caches value under key. Failing to do so only slows down later runs, so
+// errors are logged rather than returned. A nil cache does nothing.
+func (c *readCache) put(ctx context.Context, key string, value any) {
+	if c == nil {
+		return
+	}
+
+	err := func() error {
+		encoded, err := json.Marshal(value)
+		if err != nil {
+			return err
+		}
+		data, err := json.Marshal(readCacheEntry{CachedAt: time.Now(), Value: encoded})
+		if err != nil {
+			return err
+		}
+
+		if err := os.MkdirAll(c.dir, 0o700); err != nil {
+			return err
+		}
+
+		// Write to a temporary file first, so concurrent runs never read a
+		// partially written entry.
+		file, err := os.CreateTemp(c.dir, "*.tmp")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(file.Name())
+
+		if _, err := file.Write(data); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+		return os.Rename(file.Name(), c.path(key))
+	}()
+
+	if err != nil {
+		tflog.Warn(ctx, "Unable to cache Slack API response", map[string]any{
+			"cache_key": key,
+			"error":     err.Error(),
+		})
+	}
+}
+
+// cachedList returns the items cached under key if found accepts them, and
+// otherwise reads and caches them. Callers looking for a particular item pass
+// a found that checks for it, so items created since they were cached are
+// still found.
+func cachedList[T any](ctx context.Context, client *SlackClient, key string, found func([]T) bool, read func() ([]T, error)) ([]T, error) {
+	var items []T
+	if client.cache.get(ctx, key, &items) && found(items) {
+		return items, nil
+	}
+
+	items, err := read()
+	if err != nil {
+		return nil, err
+	}
+
+	client.cache.put(ctx, key, items)
+	return items, nil
+}
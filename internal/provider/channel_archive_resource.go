@@ -0,0 +1,227 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChannelArchiveResource{}
+var _ resource.ResourceWithImportState = &ChannelArchiveResource{}
+
+func NewChannelArchiveResource() resource.Resource {
+	return &ChannelArchiveResource{}
+}
+
+// ChannelArchiveResource defines the resource implementation.
+type ChannelArchiveResource struct {
+	client *SlackClient
+}
+
+// ChannelArchiveResourceModel describes the resource data model.
+type ChannelArchiveResourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ChannelId       types.String `tfsdk:"channel_id"`
+	AlreadyArchived types.Bool   `tfsdk:"already_archived"`
+}
+
+func (r *ChannelArchiveResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_archive"
+}
+
+func (r *ChannelArchiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Archives an existing channel that is not otherwise managed by Terraform, and unarchives it again on destroy.
+Use it to archive channels created outside of Terraform, such as those of resolved incidents.
+Channels that were already archived when the resource was created or imported are left archived on destroy.
+
+Bot tokens can only archive channels the bot is a member of, and Slack does not allow them to unarchive channels,
+so destroying the resource needs a user token.
+### Required Permissions
+` + "- `channels:manage` (For public channels)" + `
+` + "- `groups:write` (For private channels)" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the archived channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the channel to archive.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"already_archived": schema.BoolAttribute{
+				MarkdownDescription: "Whether the channel was already archived when the resource was created or imported, " +
+					"in which case destroying the resource does not unarchive it.",
+				Computed: true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelArchiveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ChannelArchiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelArchiveResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "conversations.archive", func() error {
+		return client.ArchiveConversationContext(ctx, data.ChannelId.ValueString())
+	})
+
+	// A channel that is already archived is taken over as it is.
+	if err != nil && err.Error() != "already_archived" {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive channel: %s, got error: %s", data.ChannelId.ValueString(), err))
+		return
+	}
+
+	data.Id = data.ChannelId
+	data.AlreadyArchived = types.BoolValue(err != nil)
+
+	tflog.Trace(ctx, "Archived a slack channel", map[string]any{"channel_id": data.ChannelId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelArchiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelArchiveResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := getChannelById(ctx, client, data.Id.ValueString())
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel, got error: %s", err))
+		return
+	}
+
+	// The channel was unarchived outside of Terraform. Dropping the resource
+	// from state makes the next apply archive it again.
+	if !channel.IsArchived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ChannelId = types.StringValue(channel.ID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelArchiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// channel_id requires replacement, so there is nothing to update.
+	var plan ChannelArchiveResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelArchiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelArchiveResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Channels archived before Terraform managed them are left as they were.
+	if data.AlreadyArchived.ValueBool() {
+		tflog.Trace(ctx, "Leaving a channel archived before Terraform managed it archived", map[string]any{"channel_id": data.Id.ValueString()})
+		return
+	}
+
+	err := client.retry(ctx, "conversations.unarchive", func() error {
+		return client.UnArchiveConversationContext(ctx, data.Id.ValueString())
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "channel_not_found", "not_archived":
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to unarchive channel, got error: %s", err))
+		return
+	}
+}
+
+func (r *ChannelArchiveResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("already_archived"), true)...)
+}
@@ -0,0 +1,83 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelArchiveResource(t *testing.T) {
+	testChannelName := "test-channel-" + testAccNameSuffix(t)
+
+	channelConfig := providerConfig + `
+resource "slack_channel" "test" {
+  name = "` + testChannelName + `"
+}
+`
+	config := channelConfig + `
+resource "slack_channel_archive" "test" {
+  channel_id = slack_channel.test.id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("slack_channel_archive.test", "id", "slack_channel.test", "id"),
+					resource.TestCheckResourceAttrPair("slack_channel_archive.test", "channel_id", "slack_channel.test", "id"),
+					resource.TestCheckResourceAttr("slack_channel_archive.test", "already_archived", "false"),
+				),
+			},
+			{
+				Config: config + `
+data "slack_channel" "archived" {
+  id = slack_channel.test.id
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.slack_channel.archived", "is_archived", "true"),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_channel_archive.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported channels are taken to be archived before Terraform managed them.
+				ImportStateVerifyIgnore: []string{"already_archived"},
+			},
+			// Archiving an archived channel takes it over as it is
+			{
+				Config: config + `
+resource "slack_channel_archive" "again" {
+  channel_id = slack_channel.test.id
+}
+`,
+				Check: resource.TestCheckResourceAttr("slack_channel_archive.again", "already_archived", "true"),
+			},
+			// Destroying a resource whose channel was already archived leaves it archived
+			{
+				Config: config + `
+data "slack_channel" "archived" {
+  id = slack_channel.test.id
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.slack_channel.archived", "is_archived", "true"),
+			},
+			// Destroying the resource unarchives the channel
+			{
+				Config: channelConfig + `
+data "slack_channel" "unarchived" {
+  id = slack_channel.test.id
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.slack_channel.unarchived", "is_archived", "false"),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// bulkArchiveLimit is the most channels admin.conversations.bulkArchive
+// accepts at once.
+const bulkArchiveLimit = 100
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChannelBulkArchiveResource{}
+
+func NewChannelBulkArchiveResource() resource.Resource {
+	return &ChannelBulkArchiveResource{}
+}
+
+// ChannelBulkArchiveResource defines the resource implementation.
+type ChannelBulkArchiveResource struct {
+	client *SlackClient
+}
+
+// ChannelBulkArchiveResourceModel describes the resource data model.
+type ChannelBulkArchiveResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	ChannelIds types.Set    `tfsdk:"channel_ids"`
+}
+
+func (r *ChannelBulkArchiveResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_bulk_archive"
+}
+
+func (r *ChannelBulkArchiveResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Archives a set of channels with ` + "`admin.conversations.bulkArchive`" + ` when it is created, and again whenever the set changes,
+such as when tearing down a project. Slack archives the channels in the background, so they may take a moment to show as archived.
+Destroying the resource does nothing.
+### Required Permissions
+- An org-level user token of an Org Admin or Owner.
+` + "- `admin.conversations:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the archival, derived from `channel_ids`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the channels to archive.",
+				Required:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelBulkArchiveResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_channel_bulk_archive")...)
+}
+
+func (r *ChannelBulkArchiveResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelBulkArchiveResourceModel
+	var channelIds []string
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.ChannelIds.ElementsAs(ctx, &channelIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	slices.Sort(channelIds)
+
+	for batch := range slices.Chunk(channelIds, bulkArchiveLimit) {
+		err := client.retry(ctx, "admin.conversations.bulkArchive", func() error {
+			return client.AdminConversationsBulkArchive(ctx, batch)
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive channels: %s, got error: %s", strings.Join(batch, ", "), err))
+			return
+		}
+	}
+
+	sum := sha256.Sum256([]byte(strings.Join(channelIds, ",")))
+	data.Id = types.StringValue(hex.EncodeToString(sum[:8]))
+
+	tflog.Trace(ctx, "Archived slack channels in bulk", map[string]any{"channel_count": len(channelIds)})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelBulkArchiveResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// An archival is not tracked after the fact, so there is nothing to
+	// refresh.
+}
+
+func (r *ChannelBulkArchiveResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing
+	// to update.
+	var plan ChannelBulkArchiveResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelBulkArchiveResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Archived channels are left archived.
+}
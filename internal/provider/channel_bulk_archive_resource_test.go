@@ -0,0 +1,47 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelBulkArchiveResource(t *testing.T) {
+	testChannelName := "test-channel-" + testAccNameSuffix(t)
+
+	config := providerConfig + `
+resource "slack_channel" "test" {
+  count = 2
+  name  = "` + testChannelName + `-${count.index}"
+}
+
+resource "slack_channel_bulk_archive" "test" {
+  channel_ids = slack_channel.test[*].id
+}
+`
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create archives the channels
+			{
+				Config: config,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("slack_channel_bulk_archive.test", "id"),
+					resource.TestCheckResourceAttr("slack_channel_bulk_archive.test", "channel_ids.#", "2"),
+				),
+			},
+			{
+				Config: config + `
+data "slack_channel" "archived" {
+  id = slack_channel.test[0].id
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.slack_channel.archived", "is_archived", "true"),
+			},
+		},
+	})
+}
@@ -6,20 +6,30 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
+	"slices"
 
 	"github.com/slack-go/slack"
 
 	"github.com/hashicorp/terraform-plugin-framework-validators/datasourcevalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+// channelListPageLimit is the maximum page size conversations.list accepts.
+// Larger pages mean far fewer round trips when searching big workspaces.
 const channelListPageLimit = 1000
 
+// defaultChannelListTypes narrows name lookups to public channels unless the
+// caller asks for other conversation types.
+var defaultChannelListTypes = []string{"public_channel"}
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ datasource.DataSource              = &ChannelDataSource{}
@@ -32,7 +42,7 @@ func NewChannelDataSource() datasource.DataSource {
 
 // ChannelDataSource defines the data source implementation.
 type ChannelDataSource struct {
-	client *slack.Client
+	client *SlackClient
 }
 
 // ChannelDataSourceModel describes the data source data model.
@@ -40,13 +50,24 @@ type ChannelDataSourceModel struct {
 	Name            types.String `tfsdk:"name"`
 	Id              types.String `tfsdk:"id"`
 	IncludeArchived types.Bool   `tfsdk:"include_archived"`
+	Types           types.Set    `tfsdk:"types"`
 	Topic           types.String `tfsdk:"topic"`
 	Description     types.String `tfsdk:"description"`
+	IsPrivate       types.Bool   `tfsdk:"is_private"`
+	IsArchived      types.Bool   `tfsdk:"is_archived"`
+	IsShared        types.Bool   `tfsdk:"is_shared"`
+	NumMembers      types.Int64  `tfsdk:"num_members"`
+	Created         types.Int64  `tfsdk:"created"`
+	Creator         types.String `tfsdk:"creator"`
+	IncludeMembers  types.Bool   `tfsdk:"include_members"`
+	Members         types.Set    `tfsdk:"members"`
+	FailIfNotFound  types.Bool   `tfsdk:"fail_if_not_found"`
+	Found           types.Bool   `tfsdk:"found"`
 }
 
 func (d *ChannelDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
 	return []datasource.ConfigValidator{
-		datasourcevalidator.Conflicting(
+		datasourcevalidator.ExactlyOneOf(
 			path.MatchRoot("id"),
 			path.MatchRoot("name"),
 		),
@@ -64,6 +85,9 @@ func (d *ChannelDataSource) Schema(ctx context.Context, req datasource.SchemaReq
 Reads a slack channel specified by name or id, and returns attributes.
 ### Required Permissions
 - ` + "`channel:read`" + `
+- ` + "`groups:read`" + ` (Only if ` + "`types`" + ` includes ` + "`private_channel`" + `)
+- ` + "`mpim:read`" + ` (Only if ` + "`types`" + ` includes ` + "`mpim`" + `)
+- ` + "`im:read`" + ` (Only if ` + "`types`" + ` includes ` + "`im`" + `)
 `,
 
 		Attributes: map[string]schema.Attribute{
@@ -80,6 +104,17 @@ Reads a slack channel specified by name or id, and returns attributes.
 				MarkdownDescription: "Set true to include archived channels.",
 				Optional:            true,
 			},
+			"types": schema.SetAttribute{
+				MarkdownDescription: "Conversation types to search when looking up a channel by name. " +
+					"Any of `public_channel`, `private_channel`, `mpim` and `im`. Defaults to `public_channel`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.Set{
+					setvalidator.ValueStringsAre(
+						stringvalidator.OneOf("public_channel", "private_channel", "mpim", "im"),
+					),
+				},
+			},
 			"topic": schema.StringAttribute{
 				MarkdownDescription: "The Channel's configured topic.",
 				Computed:            true,
@@ -88,6 +123,48 @@ Reads a slack channel specified by name or id, and returns attributes.
 				MarkdownDescription: "The Channel's configured description.",
 				Computed:            true,
 			},
+			"is_private": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the channel is private.",
+				Computed:            true,
+			},
+			"is_archived": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the channel has been archived.",
+				Computed:            true,
+			},
+			"is_shared": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the channel is shared with other workspaces or organizations.",
+				Computed:            true,
+			},
+			"num_members": schema.Int64Attribute{
+				MarkdownDescription: "The number of members in the channel.",
+				Computed:            true,
+			},
+			"created": schema.Int64Attribute{
+				MarkdownDescription: "Unix timestamp of when the channel was created.",
+				Computed:            true,
+			},
+			"creator": schema.StringAttribute{
+				MarkdownDescription: "The Slack ID of the user who created the channel.",
+				Computed:            true,
+			},
+			"include_members": schema.BoolAttribute{
+				MarkdownDescription: "Set true to populate `members` with the Slack IDs of the channel's members.",
+				Optional:            true,
+			},
+			"members": schema.SetAttribute{
+				MarkdownDescription: "Set of channel member's Slack IDs. Only populated when `include_members` is true.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"fail_if_not_found": schema.BoolAttribute{
+				MarkdownDescription: "Set false to set `found` to false and leave the channel's attributes null when it does not exist, " +
+					"rather than failing. Defaults to true.",
+				Optional: true,
+			},
+			"found": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the channel was found. Only false when `fail_if_not_found` is false.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -98,12 +175,12 @@ func (d *ChannelDataSource) Configure(ctx context.Context, req datasource.Config
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*SlackClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *slack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -112,15 +189,20 @@ func (d *ChannelDataSource) Configure(ctx context.Context, req datasource.Config
 	d.client = client
 }
 
-func getChannelById(ctx context.Context, client *slack.Client, id string) (slack.Channel, error) {
-	channel, err := client.GetConversationInfoContext(
-		ctx,
-		&slack.GetConversationInfoInput{
-			ChannelID:         id,
-			IncludeLocale:     false,
-			IncludeNumMembers: false,
-		},
-	)
+func getChannelById(ctx context.Context, client *SlackClient, id string) (slack.Channel, error) {
+	var channel *slack.Channel
+
+	err := client.retry(ctx, "conversations.info", func() (err error) {
+		channel, err = client.GetConversationInfoContext(
+			ctx,
+			&slack.GetConversationInfoInput{
+				ChannelID:         id,
+				IncludeLocale:     false,
+				IncludeNumMembers: true,
+			},
+		)
+		return err
+	})
 	if err != nil {
 		return slack.Channel{}, err
 	}
@@ -128,68 +210,124 @@ func getChannelById(ctx context.Context, client *slack.Client, id string) (slack
 
 }
 
-func getChannelByName(ctx context.Context, client *slack.Client, name string, excludeArchived bool) (slack.Channel, error) {
+// getChannelByName resolves a channel name through the shared channel index,
+// so repeated lookups only page through conversations.list once. When admin
+// channel search is enabled the name is searched for instead.
+func getChannelByName(ctx context.Context, client *SlackClient, name string, excludeArchived bool, conversationTypes []string) (slack.Channel, error) {
+	if len(conversationTypes) == 0 {
+		conversationTypes = defaultChannelListTypes
+	}
 
-	var err error
+	tflog.Trace(ctx, "Looking up channel by name", map[string]any{
+		"channel_name":       name,
+		"exclude_archived":   excludeArchived,
+		"conversation_types": conversationTypes,
+		"admin_search":       client.adminChannelSearch,
+	})
+
+	if client.adminChannelSearch {
+		return searchChannelByName(ctx, client, name, excludeArchived, conversationTypes)
+	}
+
+	return client.channels.forTypes(conversationTypes).lookup(ctx, client, name, excludeArchived)
+}
+
+// searchChannelByName finds a channel with admin.conversations.search, which
+// matches names by prefix, and then reads it by ID. Like the channel name
+// index, it prefers an active channel over an archived one of the same name.
+func searchChannelByName(ctx context.Context, client *SlackClient, name string, excludeArchived bool, conversationTypes []string) (slack.Channel, error) {
+	var match *slack.AdminConversation
 	var cursor string
-	var nextCursor string
-	var channels []slack.Channel
 
-	err = nil
-	cursor = ""
+	for {
+		var response *slack.AdminConversationsSearchResponse
+
+		err := client.retry(ctx, "admin.conversations.search", func() (err error) {
+			response, err = client.AdminConversationsSearch(
+				ctx,
+				slack.AdminConversationsSearchOptionQuery(name),
+				slack.AdminConversationsSearchOptionCursor(cursor),
+				slack.AdminConversationsSearchOptionLimit(20),
+			)
+			return err
+		})
+		if err != nil {
+			return slack.Channel{}, fmt.Errorf("error searching channels: %s", err.Error())
+		}
 
-	for err == nil {
+		for i, conversation := range response.Conversations {
+			conversationType := "public_channel"
+			if conversation.IsPrivate {
+				conversationType = "private_channel"
+			}
+
+			if conversation.Name != name || !slices.Contains(conversationTypes, conversationType) {
+				continue
+			}
+			if excludeArchived && conversation.IsArchived {
+				continue
+			}
+			if match == nil || (match.IsArchived && !conversation.IsArchived) {
+				match = &response.Conversations[i]
+			}
+		}
+
+		if (match != nil && !match.IsArchived) || response.NextCursor == "" {
+			break
+		}
+		cursor = response.NextCursor
+	}
+
+	if match == nil {
+		tflog.Trace(ctx, "Channel not found by admin search", map[string]any{"channel_name": name})
+		return slack.Channel{}, fmt.Errorf("channel_not_found")
+	}
+
+	tflog.Trace(ctx, "Found channel by admin search", map[string]any{"channel_name": name, "channel_id": match.ID})
+	return getChannelById(ctx, client, match.ID)
+}
+
+// listChannels pages through conversations.list and returns every channel of
+// the given conversation types, archived ones included.
+func listChannels(ctx context.Context, client *SlackClient, conversationTypes []string) ([]slack.Channel, error) {
+	var cursor string
+	var allChannels []slack.Channel
+
+	for {
+		var channels []slack.Channel
+		var nextCursor string
 
-		tflog.Trace(ctx, fmt.Sprintf("Exclude Archived: %t", excludeArchived))
 		params := &slack.GetConversationsParameters{
-			ExcludeArchived: excludeArchived,
+			ExcludeArchived: false,
 			Cursor:          cursor,
 			Limit:           channelListPageLimit,
+			Types:           conversationTypes,
 		}
 
-		tflog.Trace(ctx, "Next Cursor: "+cursor)
+		tflog.Trace(ctx, "Listing page of channels", map[string]any{"cursor": cursor})
 
-		channels, nextCursor, err = client.GetConversationsContext(
-			ctx,
-			params,
-		)
+		err := client.retry(ctx, "conversations.list", func() (err error) {
+			channels, nextCursor, err = client.GetConversationsContext(ctx, params)
+			return err
+		})
+		if err != nil {
+			return nil, fmt.Errorf("error listing channels: %s", err.Error())
+		}
 
-		if err == nil {
-			tflog.Trace(ctx, "Searching Page for: "+name)
+		allChannels = append(allChannels, channels...)
 
-			for _, channel := range channels {
-				if channel.Name == name {
-					tflog.Trace(ctx, "Found channel: "+name)
-					return channel, nil
-				}
-			}
-			tflog.Trace(ctx, "Channel not found in page.")
-
-			if nextCursor == "" {
-				tflog.Trace(ctx, "We have reached the last page of results and have not found this channel.")
-				return slack.Channel{}, fmt.Errorf("channel_not_found")
-			}
-			cursor = nextCursor
-			continue
-
-		} else if rateLimitedError, ok := err.(*slack.RateLimitedError); ok {
-
-			tflog.Trace(ctx, rateLimitedError.Error())
-			select {
-			case <-ctx.Done():
-				err = ctx.Err()
-				tflog.Error(ctx, "Context is Done. "+err.Error())
-			case <-time.After(rateLimitedError.RetryAfter):
-				err = nil
-			}
+		if nextCursor == "" {
+			tflog.Trace(ctx, "Listed channels", map[string]any{"channel_count": len(allChannels)})
+			return allChannels, nil
 		}
-
+		cursor = nextCursor
 	}
-
-	return slack.Channel{}, fmt.Errorf("error listing channels: %s", err.Error())
 }
 
 func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data ChannelDataSourceModel
 	var channel slack.Channel
 	var err error
@@ -201,24 +339,82 @@ func (d *ChannelDataSource) Read(ctx context.Context, req datasource.ReadRequest
 		return
 	}
 
-	if data.Id.ValueString() != "" {
+	switch {
+	case !data.Id.IsNull():
 		channel, err = getChannelById(ctx, d.client, data.Id.ValueString())
+	case !data.Name.IsNull():
+		var conversationTypes []string
+
+		resp.Diagnostics.Append(data.Types.ElementsAs(ctx, &conversationTypes, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
 
-	} else {
-		channel, err = getChannelByName(ctx, d.client, data.Name.ValueString(), !data.IncludeArchived.ValueBool())
+		channel, err = getChannelByName(ctx, d.client, data.Name.ValueString(), !data.IncludeArchived.ValueBool(), conversationTypes)
+	default:
+		resp.Diagnostics.AddError("Provider Error", "One of ID or Name needs to be provided.")
+		return
 	}
+
+	if err != nil && err.Error() == "channel_not_found" && !data.FailIfNotFound.IsNull() && !data.FailIfNotFound.ValueBool() {
+		tflog.Trace(ctx, "Channel not found, leaving attributes null", map[string]any{
+			"channel_id":   data.Id.ValueString(),
+			"channel_name": data.Name.ValueString(),
+		})
+
+		data.Found = types.BoolValue(false)
+		data.Description = types.StringNull()
+		data.Topic = types.StringNull()
+		data.IsPrivate = types.BoolNull()
+		data.IsArchived = types.BoolNull()
+		data.IsShared = types.BoolNull()
+		data.NumMembers = types.Int64Null()
+		data.Created = types.Int64Null()
+		data.Creator = types.StringNull()
+		data.Members = types.SetNull(types.StringType)
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find channel, got error: %s", err))
+		return
 	}
 
 	// Set data from API response.
+	data.Found = types.BoolValue(true)
 	data.Id = types.StringValue(channel.ID)
 	data.Name = types.StringValue(channel.Name)
 	data.Description = types.StringValue(channel.Purpose.Value)
 	data.Topic = types.StringValue(channel.Topic.Value)
+	data.IsPrivate = types.BoolValue(channel.IsPrivate)
+	data.IsArchived = types.BoolValue(channel.IsArchived)
+	data.IsShared = types.BoolValue(channel.IsShared || channel.IsExtShared || channel.IsOrgShared)
+	data.NumMembers = types.Int64Value(int64(channel.NumMembers))
+	data.Created = types.Int64Value(int64(channel.Created))
+	data.Creator = types.StringValue(channel.Creator)
 
 	data.IncludeArchived = types.BoolValue(data.IncludeArchived.ValueBool())
 
+	data.Members = types.SetNull(types.StringType)
+
+	if data.IncludeMembers.ValueBool() {
+		members, err := getChannelMembers(ctx, d.client, channel.ID)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find channel members, got error: %s", err))
+			return
+		}
+
+		var diags diag.Diagnostics
+
+		data.Members, diags = types.SetValueFrom(ctx, types.StringType, members)
+
+		resp.Diagnostics.Append(diags...)
+	}
+
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
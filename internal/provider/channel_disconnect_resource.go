@@ -0,0 +1,175 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChannelDisconnectResource{}
+
+func NewChannelDisconnectResource() resource.Resource {
+	return &ChannelDisconnectResource{}
+}
+
+// ChannelDisconnectResource defines the resource implementation.
+type ChannelDisconnectResource struct {
+	client *SlackClient
+}
+
+// ChannelDisconnectResourceModel describes the resource data model.
+type ChannelDisconnectResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	ChannelId      types.String `tfsdk:"channel_id"`
+	LeavingTeamIds types.Set    `tfsdk:"leaving_team_ids"`
+}
+
+func (r *ChannelDisconnectResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_disconnect"
+}
+
+func (r *ChannelDisconnectResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Disconnects external organizations from a Slack Connect channel with ` + "`admin.conversations.disconnectShared`" + `
+when it is created, such as when offboarding a partner. Destroying the resource does nothing:
+disconnected organizations have to be invited to the channel again.
+### Required Permissions
+- An org-level user token of an Org Admin or Owner.
+` + "- `admin.conversations:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the Slack Connect channel to disconnect.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"leaving_team_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the external workspaces to remove from the channel. " +
+					"Leave unset to disconnect every external organization.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelDisconnectResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_channel_disconnect")...)
+}
+
+func (r *ChannelDisconnectResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelDisconnectResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var options []slack.AdminConversationsDisconnectSharedOption
+
+	if !data.LeavingTeamIds.IsNull() {
+		var leavingTeamIds []string
+		resp.Diagnostics.Append(data.LeavingTeamIds.ElementsAs(ctx, &leavingTeamIds, false)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		options = append(options, slack.AdminConversationsDisconnectSharedOptionLeavingTeamIDs(leavingTeamIds))
+	}
+
+	err := client.retry(ctx, "admin.conversations.disconnectShared", func() error {
+		return client.AdminConversationsDisconnectShared(ctx, data.ChannelId.ValueString(), options...)
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disconnect channel: %s, got error: %s", data.ChannelId.ValueString(), err))
+		return
+	}
+
+	data.Id = data.ChannelId
+
+	tflog.Trace(ctx, "Disconnected a slack connect channel", map[string]any{"channel_id": data.ChannelId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelDisconnectResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A disconnect is not tracked after the fact, so there is nothing to
+	// refresh.
+}
+
+func (r *ChannelDisconnectResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing
+	// to update.
+	var plan ChannelDisconnectResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelDisconnectResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Disconnected organizations cannot be reconnected without a new invite.
+}
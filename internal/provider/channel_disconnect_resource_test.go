@@ -0,0 +1,45 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelDisconnectResource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvDisconnectChannelId)
+	teamId := testAccFixture(t, testEnvSharedTeamId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create disconnects the workspace
+			{
+				Config: providerConfig + `
+resource "slack_channel_disconnect" "test" {
+  channel_id       = "` + channelId + `"
+  leaving_team_ids = ["` + teamId + `"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_channel_disconnect.test", "id", channelId),
+					resource.TestCheckTypeSetElemAttr("slack_channel_disconnect.test", "leaving_team_ids.*", teamId),
+				),
+			},
+			// Disconnecting it again fails, as it already left
+			{
+				Config: providerConfig + `
+resource "slack_channel_disconnect" "again" {
+  channel_id       = "` + channelId + `"
+  leaving_team_ids = ["` + teamId + `"]
+}
+`,
+				ExpectError: regexp.MustCompile(`Unable to disconnect channel`),
+			},
+		},
+	})
+}
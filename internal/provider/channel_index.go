@@ -0,0 +1,141 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// channelIndexes holds one lazily built name index per set of conversation
+// types, so every name based lookup during an apply shares a single
+// conversations.list sweep.
+type channelIndexes struct {
+	mu      sync.Mutex
+	indexes map[string]*channelIndex
+}
+
+// channelIndex maps channel names to channels for one set of conversation
+// types. It is built on first use.
+type channelIndex struct {
+	mu       sync.Mutex
+	types    []string
+	built    bool
+	channels map[string]slack.Channel
+}
+
+func newChannelIndexes() *channelIndexes {
+	return &channelIndexes{
+		indexes: map[string]*channelIndex{},
+	}
+}
+
+func channelIndexKey(conversationTypes []string) string {
+	sorted := slices.Clone(conversationTypes)
+	slices.Sort(sorted)
+	return strings.Join(sorted, ",")
+}
+
+func (c *channelIndexes) forTypes(conversationTypes []string) *channelIndex {
+	key := channelIndexKey(conversationTypes)
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	index, ok := c.indexes[key]
+	if !ok {
+		index = &channelIndex{types: conversationTypes}
+		c.indexes[key] = index
+	}
+	return index
+}
+
+// put records a channel in every index that has already been built and
+// covers its conversation type, so channels created or renamed during an
+// apply can be found by later lookups.
+func (c *channelIndexes) put(channel slack.Channel, previousName string) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	for _, index := range c.indexes {
+		index.put(channel, previousName)
+	}
+}
+
+func (i *channelIndex) put(channel slack.Channel, previousName string) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !i.built || !slices.Contains(i.types, channelConversationType(channel)) {
+		return
+	}
+	if previousName != "" {
+		delete(i.channels, previousName)
+	}
+	i.channels[channel.Name] = channel
+}
+
+// lookup returns the channel with the given name, building the index with a
+// full conversations.list sweep if this is the first lookup.
+func (i *channelIndex) lookup(ctx context.Context, client *SlackClient, name string, excludeArchived bool) (slack.Channel, error) {
+	i.mu.Lock()
+	defer i.mu.Unlock()
+
+	if !i.built {
+		tflog.Trace(ctx, "Building channel name index", map[string]any{"conversation_types": i.types})
+
+		channels, err := cachedList(ctx, client, "conversations.list/"+channelIndexKey(i.types),
+			func(channels []slack.Channel) bool {
+				return slices.ContainsFunc(channels, func(channel slack.Channel) bool {
+					return channel.Name == name && !(excludeArchived && channel.IsArchived)
+				})
+			},
+			func() ([]slack.Channel, error) {
+				return listChannels(ctx, client, i.types)
+			},
+		)
+		if err != nil {
+			return slack.Channel{}, err
+		}
+
+		i.channels = make(map[string]slack.Channel, len(channels))
+		for _, channel := range channels {
+			// Prefer an active channel over an archived one of the same name.
+			if existing, ok := i.channels[channel.Name]; ok && !existing.IsArchived {
+				continue
+			}
+			i.channels[channel.Name] = channel
+		}
+		i.built = true
+	}
+
+	channel, ok := i.channels[name]
+	if !ok || (excludeArchived && channel.IsArchived) {
+		tflog.Trace(ctx, "Channel not found in index", map[string]any{"channel_name": name})
+		return slack.Channel{}, fmt.Errorf("channel_not_found")
+	}
+
+	tflog.Trace(ctx, "Found channel in index", map[string]any{"channel_name": name, "channel_id": channel.ID})
+	return channel, nil
+}
+
+func channelConversationType(channel slack.Channel) string {
+	switch {
+	case channel.IsIM:
+		return "im"
+	case channel.IsMpIM:
+		return "mpim"
+	case channel.IsPrivate:
+		return "private_channel"
+	default:
+		return "public_channel"
+	}
+}
@@ -0,0 +1,95 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+)
+
+func testChannel(id string, name string, isPrivate bool, isArchived bool) slack.Channel {
+	channel := slack.Channel{}
+	channel.ID = id
+	channel.Name = name
+	channel.IsPrivate = isPrivate
+	channel.IsArchived = isArchived
+	return channel
+}
+
+func TestChannelIndexesForTypesSharesIndex(t *testing.T) {
+	indexes := newChannelIndexes()
+
+	a := indexes.forTypes([]string{"public_channel", "private_channel"})
+	b := indexes.forTypes([]string{"private_channel", "public_channel"})
+
+	if a != b {
+		t.Fatal("expected the same index regardless of type order")
+	}
+	if indexes.forTypes([]string{"public_channel"}) == a {
+		t.Fatal("expected a distinct index for a different set of types")
+	}
+}
+
+func TestChannelIndexPut(t *testing.T) {
+	indexes := newChannelIndexes()
+	public := indexes.forTypes([]string{"public_channel"})
+	public.built = true
+	public.channels = map[string]slack.Channel{}
+	unbuilt := indexes.forTypes([]string{"private_channel"})
+
+	indexes.put(testChannel("C1", "first", false, false), "")
+	indexes.put(testChannel("C2", "secret", true, false), "")
+
+	if _, err := public.lookup(context.Background(), nil, "first", true); err != nil {
+		t.Fatalf("expected to find channel, got error: %s", err)
+	}
+	if _, err := public.lookup(context.Background(), nil, "secret", true); err == nil {
+		t.Fatal("expected private channel to be left out of the public index")
+	}
+	if unbuilt.built {
+		t.Fatal("expected put not to build an index")
+	}
+
+	indexes.put(testChannel("C1", "renamed", false, false), "first")
+
+	if _, err := public.lookup(context.Background(), nil, "first", true); err == nil {
+		t.Fatal("expected the previous name to be removed")
+	}
+	if channel, err := public.lookup(context.Background(), nil, "renamed", true); err != nil || channel.ID != "C1" {
+		t.Fatalf("expected renamed channel C1, got %q and error: %v", channel.ID, err)
+	}
+}
+
+func TestChannelIndexLookupExcludesArchived(t *testing.T) {
+	index := &channelIndex{
+		types: []string{"public_channel"},
+		built: true,
+		channels: map[string]slack.Channel{
+			"old": testChannel("C1", "old", false, true),
+		},
+	}
+
+	if _, err := index.lookup(context.Background(), nil, "old", true); err == nil {
+		t.Fatal("expected archived channel to be excluded")
+	}
+	if _, err := index.lookup(context.Background(), nil, "old", false); err != nil {
+		t.Fatalf("expected archived channel to be found, got error: %s", err)
+	}
+}
+
+func TestChannelIndexLookupSharesCacheRegardlessOfTypeOrder(t *testing.T) {
+	ctx := context.Background()
+	client := &SlackClient{cache: &readCache{dir: t.TempDir(), ttl: time.Minute}}
+	client.cache.put(ctx, "conversations.list/"+channelIndexKey([]string{"public_channel", "private_channel"}),
+		[]slack.Channel{testChannel("C1", "general", false, false)})
+
+	// A cache miss would list channels with the nil Client and panic.
+	index := newChannelIndexes().forTypes([]string{"public_channel", "private_channel"})
+	if channel, err := index.lookup(ctx, client, "general", true); err != nil || channel.ID != "C1" {
+		t.Fatalf("expected cached channel C1, got %q and error: %v", channel.ID, err)
+	}
+}
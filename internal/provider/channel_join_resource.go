@@ -0,0 +1,208 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChannelJoinResource{}
+var _ resource.ResourceWithImportState = &ChannelJoinResource{}
+
+func NewChannelJoinResource() resource.Resource {
+	return &ChannelJoinResource{}
+}
+
+// ChannelJoinResource defines the resource implementation.
+type ChannelJoinResource struct {
+	client *SlackClient
+}
+
+// ChannelJoinResourceModel describes the resource data model.
+type ChannelJoinResourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	ChannelId types.String `tfsdk:"channel_id"`
+}
+
+func (r *ChannelJoinResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_join"
+}
+
+func (r *ChannelJoinResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Makes the bot join a public channel, and leave it again on destroy.
+The bot has to be a member of a channel before it can post, pin or bookmark in it.
+### Required Permissions
+` + "- `channels:join`" + `
+` + "- `channels:manage` (To leave the channel)" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the joined channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the public channel to join.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelJoinResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ChannelJoinResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelJoinResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Joining a channel the bot is already in only returns a warning.
+	err := client.retry(ctx, "conversations.join", func() error {
+		_, _, _, err := client.JoinConversationContext(ctx, data.ChannelId.ValueString())
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to join channel: %s, got error: %s", data.ChannelId.ValueString(), err))
+		return
+	}
+
+	data.Id = data.ChannelId
+
+	tflog.Trace(ctx, "Joined a slack channel", map[string]any{"channel_id": data.ChannelId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelJoinResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelJoinResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := getChannelById(ctx, client, data.Id.ValueString())
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel, got error: %s", err))
+		return
+	}
+
+	// The bot was removed from the channel, or it was archived, outside of
+	// Terraform. Dropping the resource from state makes the next apply
+	// join again.
+	if !channel.IsMember || channel.IsArchived {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.ChannelId = types.StringValue(channel.ID)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelJoinResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// channel_id requires replacement, so there is nothing to update.
+	var plan ChannelJoinResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelJoinResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelJoinResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "conversations.leave", func() error {
+		_, err := client.LeaveConversationContext(ctx, data.Id.ValueString())
+		return err
+	})
+
+	if err != nil {
+		switch err.Error() {
+		case "channel_not_found", "is_archived", "not_in_channel":
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to leave channel, got error: %s", err))
+		return
+	}
+}
+
+func (r *ChannelJoinResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+}
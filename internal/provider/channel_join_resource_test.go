@@ -0,0 +1,39 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelJoinResource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvJoinChannelId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_channel_join" "test" {
+  channel_id = "` + channelId + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_channel_join.test", "id", channelId),
+					resource.TestCheckResourceAttr("slack_channel_join.test", "channel_id", channelId),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_channel_join.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,197 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &ChannelListResource{}
+var _ list.ListResourceWithConfigure = &ChannelListResource{}
+
+func NewChannelListResource() list.ListResource {
+	return &ChannelListResource{}
+}
+
+// ChannelListResource defines the list resource implementation.
+type ChannelListResource struct {
+	client *SlackClient
+}
+
+// ChannelListResourceModel describes the list resource config data model.
+type ChannelListResourceModel struct {
+	Types      types.List   `tfsdk:"types"`
+	NamePrefix types.String `tfsdk:"name_prefix"`
+	NameRegex  types.String `tfsdk:"name_regex"`
+	OnlyMember types.Bool   `tfsdk:"only_member"`
+}
+
+func (r *ChannelListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel"
+}
+
+func (r *ChannelListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Lists the channels in the workspace that can be managed as ` + "`slack_channel`" + ` resources. Archived channels are not listed.
+Use ` + "`terraform query -generate-config-out=channels.tf`" + ` to generate the import blocks and configuration of every listed channel.
+### Required Permissions
+` + "- `channels:read`" + `
+` + "- `groups:read` (Only if `private_channel` is listed)" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"name_prefix": schema.StringAttribute{
+				MarkdownDescription: "Only list channels whose name starts with this prefix, such as `team-`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"name_regex": schema.StringAttribute{
+				MarkdownDescription: "Only list channels whose name matches this regular expression, such as `^incident-[0-9]+$`. " +
+					"Combined with `name_prefix`, channels have to match both.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthAtLeast(1),
+				},
+			},
+			"only_member": schema.BoolAttribute{
+				MarkdownDescription: "Set true to only list channels the user the provider's token acts as is a member of, " +
+					"such as the bot that manages them.",
+				Optional: true,
+			},
+			"types": schema.ListAttribute{
+				MarkdownDescription: "Conversation types to list. " +
+					"Any of `public_channel` and `private_channel`. Defaults to `public_channel`.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(
+						stringvalidator.OneOf("public_channel", "private_channel"),
+					),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ChannelListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var data ChannelListResourceModel
+	var diags diag.Diagnostics
+	client := r.client
+
+	diags.Append(req.Config.Get(ctx, &data)...)
+
+	if diags.HasError() {
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	conversationTypes := defaultChannelListTypes
+	if !data.Types.IsNull() {
+		conversationTypes = nil
+		diags.Append(data.Types.ElementsAs(ctx, &conversationTypes, false)...)
+
+		if diags.HasError() {
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	namePrefix := normalizeChannelName(data.NamePrefix.ValueString())
+
+	var nameRegex *regexp.Regexp
+	if !data.NameRegex.IsNull() {
+		var err error
+		nameRegex, err = regexp.Compile(data.NameRegex.ValueString())
+
+		if err != nil {
+			diags.AddAttributeError(path.Root("name_regex"), "Invalid Regular Expression", err.Error())
+			stream.Results = list.ListResultsStreamDiagnostics(diags)
+			return
+		}
+	}
+
+	channels, err := listChannels(ctx, client, conversationTypes)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list channels, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		var count int64
+
+		for _, channel := range channels {
+			if channel.IsArchived || !strings.HasPrefix(channel.Name, namePrefix) {
+				continue
+			}
+			if nameRegex != nil && !nameRegex.MatchString(channel.Name) {
+				continue
+			}
+			if data.OnlyMember.ValueBool() && !channel.IsMember {
+				continue
+			}
+			if req.Limit > 0 && count >= req.Limit {
+				return
+			}
+			count++
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = "#" + channel.Name
+
+			result.Diagnostics.Append(client.setIdentity(ctx, result.Identity, channel.ID)...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, ChannelResourceModel{
+					Id:          types.StringValue(channel.ID),
+					Name:        NewChannelNameValue(channel.Name),
+					IsPrivate:   types.BoolValue(channel.IsPrivate),
+					Topic:       NewSlackTextValue(channel.Topic.Value),
+					Description: NewSlackTextValue(channel.Purpose.Value),
+				})...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
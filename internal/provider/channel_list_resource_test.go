@@ -0,0 +1,132 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccChannelListResource(t *testing.T) {
+	testChannelName := "test-channel-" + testAccNameSuffix(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// List resources require Terraform 1.14.0 or later.
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Create a channel to list
+			{
+				Config: providerConfig + `
+resource "slack_channel" "test" {
+  name = "` + testChannelName + `"
+}
+`,
+			},
+			// Query testing
+			{
+				Query: true,
+				Config: providerConfig + `
+list "slack_channel" "test" {
+  provider         = slack
+  include_resource = true
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("slack_channel.test", 1),
+					querycheck.ExpectResourceKnownValues(
+						"slack_channel.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact("#"+testChannelName)),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("name"),
+								KnownValue: knownvalue.StringExact(testChannelName),
+							},
+							{
+								Path:       tfjsonpath.New("is_private"),
+								KnownValue: knownvalue.Bool(false),
+							},
+						},
+					),
+				},
+			},
+			// Query testing by prefix
+			{
+				Query: true,
+				Config: providerConfig + `
+list "slack_channel" "prefixed" {
+  provider = slack
+
+  config {
+    name_prefix = "` + testChannelName + `"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("slack_channel.prefixed", 1),
+					querycheck.ExpectResourceDisplayName(
+						"slack_channel.prefixed",
+						queryfilter.ByDisplayName(knownvalue.StringExact("#"+testChannelName)),
+						knownvalue.StringExact("#"+testChannelName),
+					),
+				},
+			},
+			// Query testing by membership, the channel's creator is a member
+			{
+				Query: true,
+				Config: providerConfig + `
+list "slack_channel" "member" {
+  provider = slack
+
+  config {
+    name_prefix = "` + testChannelName + `"
+    only_member = true
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("slack_channel.member", 1),
+				},
+			},
+			// Query testing by regular expression
+			{
+				Query: true,
+				Config: providerConfig + `
+list "slack_channel" "matching" {
+  provider = slack
+
+  config {
+    name_regex = "^` + testChannelName + `$"
+  }
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLength("slack_channel.matching", 1),
+				},
+			},
+			{
+				Query: true,
+				Config: providerConfig + `
+list "slack_channel" "invalid" {
+  provider = slack
+
+  config {
+    name_regex = "incident-("
+  }
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Regular Expression`),
+			},
+		},
+	})
+}
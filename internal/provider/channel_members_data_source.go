@@ -6,15 +6,24 @@ package provider
 import (
 	"context"
 	"fmt"
+	"slices"
 
 	"github.com/slack-go/slack"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
+// channelMembersPageLimit is the page size requested from
+// conversations.members. Larger pages mean far fewer round trips when reading
+// channels with tens of thousands of members.
+const channelMembersPageLimit = 1000
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var (
 	_ datasource.DataSource              = &ChannelMembersDataSource{}
@@ -27,13 +36,35 @@ func NewChannelMembersDataSource() datasource.DataSource {
 
 // ChannelMembersDataSource defines the data source implementation.
 type ChannelMembersDataSource struct {
-	client *slack.Client
+	client *SlackClient
 }
 
 // ChannelMembersDataSourceModel describes the data source data model.
 type ChannelMembersDataSourceModel struct {
-	Id      types.String `tfsdk:"id"`
-	Members types.Set    `tfsdk:"members"`
+	Id         types.String `tfsdk:"id"`
+	ChannelId  types.String `tfsdk:"channel_id"`
+	Members    types.Set    `tfsdk:"members"`
+	MaxMembers types.Int64  `tfsdk:"max_members"`
+	Truncated  types.Bool   `tfsdk:"truncated"`
+
+	ExcludeBots        types.Bool `tfsdk:"exclude_bots"`
+	ExcludeDeactivated types.Bool `tfsdk:"exclude_deactivated"`
+	ExcludeSelf        types.Bool `tfsdk:"exclude_self"`
+	IncludeDetails     types.Bool `tfsdk:"include_details"`
+	MemberDetails      types.List `tfsdk:"member_details"`
+}
+
+// ChannelMemberDetailsModel describes a single entry of member_details.
+type ChannelMemberDetailsModel struct {
+	Id    types.String `tfsdk:"id"`
+	Name  types.String `tfsdk:"name"`
+	Email types.String `tfsdk:"email"`
+}
+
+var channelMemberDetailsAttrTypes = map[string]attr.Type{
+	"id":    types.StringType,
+	"name":  types.StringType,
+	"email": types.StringType,
 }
 
 func (d *ChannelMembersDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
@@ -47,18 +78,74 @@ func (d *ChannelMembersDataSource) Schema(ctx context.Context, req datasource.Sc
 Gets the Slack IDs of a given channel's members.
 ### Required Permissions
 - ` + "`channels:read`" + `
+- ` + "`users:read`" + ` (Only if ` + "`exclude_bots`" + `, ` + "`exclude_deactivated`" + ` or ` + "`include_details`" + ` is set)
+- ` + "`users:read.email`" + ` (Only if ` + "`include_details`" + ` is set)
 `,
 		Attributes: map[string]schema.Attribute{
 			"id": schema.StringAttribute{
-				MarkdownDescription: "The ChannelMembers ID",
-				Optional:            true,
+				MarkdownDescription: "The ID of the channel to read members from.",
+				Required:            true,
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel the members were read from.",
 				Computed:            true,
 			},
 			"members": schema.SetAttribute{
 				MarkdownDescription: "Set of channel member's Slack IDs.",
-				Optional:            true,
+				Computed:            true,
 				ElementType:         types.StringType,
 			},
+			"max_members": schema.Int64Attribute{
+				MarkdownDescription: "Stop reading members once this many have been read. Members beyond the cap are left out " +
+					"of the results, which keeps refreshes of channels with tens of thousands of members fast. " +
+					"The cap applies before `exclude_bots`, `exclude_deactivated` and `exclude_self` filter the members.",
+				Optional: true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"truncated": schema.BoolAttribute{
+				MarkdownDescription: "Whether `max_members` left members out of the results.",
+				Computed:            true,
+			},
+			"exclude_bots": schema.BoolAttribute{
+				MarkdownDescription: "Set true to leave bot users out of the results.",
+				Optional:            true,
+			},
+			"exclude_deactivated": schema.BoolAttribute{
+				MarkdownDescription: "Set true to leave deactivated users out of the results.",
+				Optional:            true,
+			},
+			"exclude_self": schema.BoolAttribute{
+				MarkdownDescription: "Set true to leave the user the provider's token acts as out of the results, " +
+					"such as a bot that has to be in the channel to manage it.",
+				Optional: true,
+			},
+			"include_details": schema.BoolAttribute{
+				MarkdownDescription: "Set true to populate `member_details` with the id, name and email of each member.",
+				Optional:            true,
+			},
+			"member_details": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each channel member. Only populated when `include_details` is true. " +
+					"Members that cannot be looked up only have their id set, with a warning.",
+				Computed: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The member's Slack ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The member's Slack handle.",
+							Computed:            true,
+						},
+						"email": schema.StringAttribute{
+							MarkdownDescription: "The member's email address.",
+							Computed:            true,
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -69,12 +156,12 @@ func (d *ChannelMembersDataSource) Configure(ctx context.Context, req datasource
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*SlackClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *slack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -84,6 +171,9 @@ func (d *ChannelMembersDataSource) Configure(ctx context.Context, req datasource
 }
 
 func (d *ChannelMembersDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data ChannelMembersDataSourceModel
 
 	// Read Terraform configuration data into the model
@@ -93,36 +183,91 @@ func (d *ChannelMembersDataSource) Read(ctx context.Context, req datasource.Read
 		return
 	}
 
-	var allMembers []string
+	allMembers, truncated, err := getChannelMembersLimit(ctx, d.client, data.Id.ValueString(), int(data.MaxMembers.ValueInt64()))
 
-	members, next, err := d.client.GetUsersInConversationContext(
-		ctx,
-		&slack.GetUsersInConversationParameters{
-			ChannelID: data.Id.ValueString(),
-		},
-	)
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find channel members, got error: %s", err))
+		return
 	}
-	allMembers = append(allMembers, members...)
-
-	for next != "" {
-		members, next, err = d.client.GetUsersInConversationContext(
-			ctx,
-			&slack.GetUsersInConversationParameters{
-				ChannelID: data.Id.ValueString(),
-				Cursor:    next,
-			},
-		)
-		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find channel members, got error: %s", err))
-		}
-		allMembers = append(allMembers, members...)
+
+	if data.ExcludeSelf.ValueBool() {
+		allMembers = slices.DeleteFunc(allMembers, func(id string) bool { return id == d.client.userId })
 	}
 
 	var diags diag.Diagnostics
 
+	data.MemberDetails = types.ListNull(types.ObjectType{AttrTypes: channelMemberDetailsAttrTypes})
+
+	if data.ExcludeBots.ValueBool() || data.ExcludeDeactivated.ValueBool() || data.IncludeDetails.ValueBool() {
+		users, err := getUsersById(ctx, d.client)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+			return
+		}
+
+		var filtered []string
+		var details []ChannelMemberDetailsModel
+
+		for _, id := range allMembers {
+			user, ok := users[id]
+
+			// users.list leaves out members of other organizations in
+			// shared channels, so those are looked up one by one.
+			if !ok {
+				var member *slack.User
+				err := d.client.retry(ctx, "users.info", func() (err error) {
+					member, err = d.client.GetUserInfoContext(ctx, id)
+					return err
+				})
+
+				if err != nil {
+					resp.Diagnostics.AddWarning(
+						"Unable to Find Channel Member",
+						fmt.Sprintf("Unable to look up member %s of channel %s, so it is not filtered and its details are left unset, got error: %s", id, data.Id.ValueString(), err),
+					)
+				} else {
+					user, ok = *member, true
+				}
+			}
+
+			if ok && data.ExcludeBots.ValueBool() && (user.IsBot || user.IsAppUser) {
+				continue
+			}
+			if ok && data.ExcludeDeactivated.ValueBool() && user.Deleted {
+				continue
+			}
+
+			filtered = append(filtered, id)
+
+			if !ok {
+				details = append(details, ChannelMemberDetailsModel{
+					Id:    types.StringValue(id),
+					Name:  types.StringNull(),
+					Email: types.StringNull(),
+				})
+				continue
+			}
+
+			details = append(details, ChannelMemberDetailsModel{
+				Id:    types.StringValue(id),
+				Name:  types.StringValue(user.Name),
+				Email: d.client.emailValue(user.Profile.Email),
+			})
+		}
+		allMembers = filtered
+
+		if data.IncludeDetails.ValueBool() {
+			data.MemberDetails, diags = types.ListValueFrom(
+				ctx, types.ObjectType{AttrTypes: channelMemberDetailsAttrTypes}, details,
+			)
+			resp.Diagnostics.Append(diags...)
+		}
+	}
+
 	// Set data from API response.
+	data.ChannelId = data.Id
+	data.Truncated = types.BoolValue(truncated)
 	data.Members, diags = types.SetValueFrom(ctx, types.StringType, allMembers)
 
 	resp.Diagnostics.Append(diags...)
@@ -130,3 +275,89 @@ func (d *ChannelMembersDataSource) Read(ctx context.Context, req datasource.Read
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
 }
+
+// getChannelMembers pages through conversations.members and returns the Slack
+// IDs of every member of the channel.
+func getChannelMembers(ctx context.Context, client *SlackClient, channelID string) ([]string, error) {
+	members, _, err := getChannelMembersLimit(ctx, client, channelID, 0)
+	return members, err
+}
+
+// getChannelMembersLimit is getChannelMembers, but stops paging once
+// maxMembers have been read, reporting whether members were left out. A
+// maxMembers of zero reads every member.
+//
+// conversations.members pages by cursor, so pages cannot be fetched in
+// parallel; capping the number of members read is the only way to bound the
+// time huge channels take to read.
+func getChannelMembersLimit(ctx context.Context, client *SlackClient, channelID string, maxMembers int) ([]string, bool, error) {
+	var allMembers []string
+	truncated := false
+
+	err := listChannelMembers(ctx, client, channelID, func(members []string, more bool) bool {
+		allMembers = append(allMembers, members...)
+		if maxMembers > 0 && len(allMembers) >= maxMembers {
+			truncated = more || len(allMembers) > maxMembers
+			allMembers = allMembers[:maxMembers]
+			return true
+		}
+		return false
+	})
+
+	return allMembers, truncated, err
+}
+
+// listChannelMembers pages through conversations.members, handing each page
+// of member IDs to found until it returns true. found is also told whether
+// more pages follow.
+func listChannelMembers(ctx context.Context, client *SlackClient, channelID string, found func(members []string, more bool) bool) error {
+	var cursor string
+
+	for {
+		var members []string
+		var next string
+
+		err := client.retry(ctx, "conversations.members", func() (err error) {
+			members, next, err = client.GetUsersInConversationContext(
+				ctx,
+				&slack.GetUsersInConversationParameters{
+					ChannelID: channelID,
+					Cursor:    cursor,
+					Limit:     channelMembersPageLimit,
+				},
+			)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+
+		if found(members, next != "") || next == "" {
+			return nil
+		}
+		cursor = next
+	}
+}
+
+// getUsersById lists every user in the workspace once and indexes them by
+// Slack ID, so member filtering doesn't need a users.info call per member.
+func getUsersById(ctx context.Context, client *SlackClient) (map[string]slack.User, error) {
+	var users []slack.User
+
+	err := client.retry(ctx, "users.list", func() (err error) {
+		users, err = client.GetUsersContext(ctx)
+		return err
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	byId := make(map[string]slack.User, len(users))
+
+	for _, user := range users {
+		byId[user.ID] = user
+	}
+
+	return byId, nil
+}
@@ -4,25 +4,56 @@
 package provider
 
 import (
+	"context"
+	"fmt"
 	"regexp"
+	"strings"
 	"testing"
 
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
 )
 
-const testDataSourceChannelMembersChannelId = "C086QLHRNV6"
-const testDataSourceChannelMembersChannelMemberId = "U085RJKA41X"
-
 func TestAccChannelMembersDataSource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvMembersChannelId)
+	memberId := testAccFixture(t, testEnvMembersChannelMember)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviders(t),
 		Steps: []resource.TestStep{
 			{
-				Config: providerConfig + testAccChannelMembersDataSourceConfig,
+				Config: providerConfig + testAccChannelMembersDataSourceConfig(channelId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_channel_members.test", "id", channelId),
+					resource.TestCheckResourceAttr("data.slack_channel_members.test", "channel_id", channelId),
+					resource.TestCheckTypeSetElemAttr("data.slack_channel_members.test", "members.*", memberId),
+				),
+			},
+			{
+				Config: providerConfig + testAccChannelMembersDataSourceConfigWithDetails(channelId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.slack_channel_members.test_with_details", "members.*", memberId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_channel_members.test_with_details", "member_details.*", map[string]string{
+						"id": memberId,
+					}),
+				),
+			},
+			{
+				Config: providerConfig + testAccChannelMembersDataSourceConfigExcludeSelf(channelId),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.slack_channel_members.test", "id", testDataSourceChannelMembersChannelId),
-					resource.TestCheckTypeSetElemAttr("data.slack_channel_members.test", "members.*", testDataSourceChannelMembersChannelMemberId),
+					resource.TestCheckTypeSetElemAttr("data.slack_channel_members.test_exclude_self", "members.*", memberId),
+					testAccCheckChannelMembersExcludeSelf("data.slack_channel_members.test_exclude_self", "data.slack_token_scopes.self"),
+				),
+			},
+			{
+				Config: providerConfig + testAccChannelMembersDataSourceConfigMaxMembers(channelId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_channel_members.test_max_members", "members.#", "1"),
+					resource.TestCheckResourceAttr("data.slack_channel_members.test_max_members", "truncated", "true"),
 				),
 			},
 			{
@@ -32,18 +63,124 @@ func TestAccChannelMembersDataSource(t *testing.T) {
 				),
 				ExpectError: regexp.MustCompile(`Unable to find channel`),
 			},
+			{
+				Config:      providerConfig + testAccChannelMembersDataSourceConfigMissingId,
+				ExpectError: regexp.MustCompile(`Missing required argument`),
+			},
 		},
 	})
 }
 
-const testAccChannelMembersDataSourceConfig = `
+func TestChannelMembersDataSourceDetailsOfUnlistedMembers(t *testing.T) {
+	ctx := context.Background()
+
+	m := newMockSlack()
+	m.users["U0MOCKPARTNER"] = &slack.User{ID: "U0MOCKPARTNER", TeamID: mockPartnerTeamId, Name: "partner-user"}
+	channel := m.channels[mockMembersChannelId]
+	channel.Members = append(channel.Members, "U0MOCKPARTNER", "U0MOCKUNKNOWN")
+
+	state, diags := m.readDataSource(t, &ChannelMembersDataSource{}, map[string]any{
+		"id":              mockMembersChannelId,
+		"include_details": true,
+	})
+	if diags.ErrorsCount() > 0 {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if diags.WarningsCount() != 1 || !strings.Contains(diags.Warnings()[0].Detail(), "U0MOCKUNKNOWN") {
+		t.Errorf("expected a warning about the member that cannot be looked up, got: %v", diags)
+	}
+
+	var data ChannelMembersDataSourceModel
+	if diags := state.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	var details []ChannelMemberDetailsModel
+	if diags := data.MemberDetails.ElementsAs(ctx, &details, false); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+
+	names := map[string]types.String{}
+	for _, detail := range details {
+		names[detail.Id.ValueString()] = detail.Name
+	}
+	if names["U0MOCKPARTNER"].ValueString() != "partner-user" {
+		t.Errorf("expected the member missing from users.list to be looked up, got: %s", names["U0MOCKPARTNER"])
+	}
+	if !names["U0MOCKUNKNOWN"].IsNull() {
+		t.Errorf("expected the name of a member that cannot be looked up to be unset, got: %s", names["U0MOCKUNKNOWN"])
+	}
+}
+
+func testAccChannelMembersDataSourceConfig(id string) string {
+	return `
 data "slack_channel_members" "test" {
-  id = "` + testDataSourceChannelMembersChannelId + `"
+  id = "` + id + `"
+}
+`
+}
+
+func testAccChannelMembersDataSourceConfigWithDetails(id string) string {
+	return `
+data "slack_channel_members" "test_with_details" {
+  id                  = "` + id + `"
+  exclude_bots        = true
+  exclude_deactivated = true
+  include_details     = true
+}
+`
+}
+
+func testAccChannelMembersDataSourceConfigExcludeSelf(id string) string {
+	return `
+data "slack_token_scopes" "self" {}
+
+data "slack_channel_members" "test_exclude_self" {
+  id           = "` + id + `"
+  exclude_self = true
 }
 `
+}
+
+func testAccChannelMembersDataSourceConfigMaxMembers(id string) string {
+	return `
+data "slack_channel_members" "test_max_members" {
+  id          = "` + id + `"
+  max_members = 1
+}
+`
+}
+
+// testAccCheckChannelMembersExcludeSelf checks that the user the token acts
+// as, read from the slack_token_scopes data source tokenName, is not among
+// the members of the slack_channel_members data source name.
+func testAccCheckChannelMembersExcludeSelf(name string, tokenName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		members, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+		token, ok := s.RootModule().Resources[tokenName]
+		if !ok {
+			return fmt.Errorf("not found: %s", tokenName)
+		}
+
+		self := token.Primary.Attributes["user_id"]
+		for key, value := range members.Primary.Attributes {
+			if strings.HasPrefix(key, "members.") && key != "members.#" && value == self {
+				return fmt.Errorf("expected %s to leave out %s", name, self)
+			}
+		}
+		return nil
+	}
+}
 
 const testAccChannelMembersDataSourceConfigChannelDoesNotExist = `
 data "slack_channel_members" "does_not_exist" {
   id = "CDOESNOTEXIST"
 }
 `
+
+const testAccChannelMembersDataSourceConfigMissingId = `
+data "slack_channel_members" "missing_id" {
+}
+`
@@ -0,0 +1,125 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ChannelMembershipDataSource{}
+	_ datasource.DataSourceWithConfigure = &ChannelMembershipDataSource{}
+)
+
+func NewChannelMembershipDataSource() datasource.DataSource {
+	return &ChannelMembershipDataSource{}
+}
+
+// ChannelMembershipDataSource defines the data source implementation.
+type ChannelMembershipDataSource struct {
+	client *SlackClient
+}
+
+// ChannelMembershipDataSourceModel describes the data source data model.
+type ChannelMembershipDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	ChannelId types.String `tfsdk:"channel_id"`
+	UserId    types.String `tfsdk:"user_id"`
+	IsMember  types.Bool   `tfsdk:"is_member"`
+}
+
+func (d *ChannelMembershipDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_membership"
+}
+
+func (d *ChannelMembershipDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Checks whether a user is a member of a channel, without keeping the channel's members in state like ` + "`slack_channel_members`" + ` does.
+Pages of members are only requested until the user is found.
+### Required Permissions
+- ` + "`channels:read`" + `
+- ` + "`groups:read`" + ` (Only for private channels)
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the membership, in the form `channel_id:user_id`.",
+				Computed:            true,
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel.",
+				Required:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user.",
+				Required:            true,
+			},
+			"is_member": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a member of the channel.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ChannelMembershipDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ChannelMembershipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelMembershipDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	isMember := false
+
+	err := listChannelMembers(ctx, d.client, data.ChannelId.ValueString(), func(members []string, more bool) bool {
+		isMember = slices.Contains(members, data.UserId.ValueString())
+		return isMember
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find channel members, got error: %s", err))
+		return
+	}
+
+	// Set data from API response.
+	data.Id = types.StringValue(data.ChannelId.ValueString() + ":" + data.UserId.ValueString())
+	data.IsMember = types.BoolValue(isMember)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
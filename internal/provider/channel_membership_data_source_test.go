@@ -0,0 +1,49 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelMembershipDataSource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvMembersChannelId)
+	memberId := testAccFixture(t, testEnvMembersChannelMember)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccChannelMembershipDataSourceConfig(channelId, memberId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_channel_membership.test", "id", channelId+":"+memberId),
+					resource.TestCheckResourceAttr("data.slack_channel_membership.test", "is_member", "true"),
+				),
+			},
+			{
+				Config: providerConfig + testAccChannelMembershipDataSourceConfig(channelId, "UDOESNOTEXIST"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_channel_membership.test", "is_member", "false"),
+				),
+			},
+			{
+				Config:      providerConfig + testAccChannelMembershipDataSourceConfig("CDOESNOTEXIST", memberId),
+				ExpectError: regexp.MustCompile(`Unable to find channel members`),
+			},
+		},
+	})
+}
+
+func testAccChannelMembershipDataSourceConfig(channelId string, userId string) string {
+	return `
+data "slack_channel_membership" "test" {
+  channel_id = "` + channelId + `"
+  user_id    = "` + userId + `"
+}
+`
+}
@@ -0,0 +1,109 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = ChannelNameType{}
+var _ basetypes.StringValuableWithSemanticEquals = ChannelNameValue{}
+
+// ChannelNameType is a string attribute type for channel names. Slack
+// lowercases names and replaces spaces with hyphens on save, so a name read
+// back may differ from the one configured. Names that only differ in this way
+// are semantically equal, which keeps them from showing as a permanent diff.
+//
+// Terraform does not allow planning a value other than the configured one, so
+// the configured name is kept in state rather than normalized in the plan.
+type ChannelNameType struct {
+	basetypes.StringType
+}
+
+func (t ChannelNameType) String() string {
+	return "ChannelNameType"
+}
+
+func (t ChannelNameType) Equal(o attr.Type) bool {
+	other, ok := o.(ChannelNameType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t ChannelNameType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return ChannelNameValue{StringValue: in}, nil
+}
+
+func (t ChannelNameType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return ChannelNameValue{StringValue: stringValue}, nil
+}
+
+func (t ChannelNameType) ValueType(ctx context.Context) attr.Value {
+	return ChannelNameValue{}
+}
+
+// ChannelNameValue is a value of ChannelNameType.
+type ChannelNameValue struct {
+	basetypes.StringValue
+}
+
+func NewChannelNameValue(value string) ChannelNameValue {
+	return ChannelNameValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v ChannelNameValue) Type(ctx context.Context) attr.Type {
+	return ChannelNameType{}
+}
+
+func (v ChannelNameValue) Equal(o attr.Value) bool {
+	other, ok := o.(ChannelNameValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v ChannelNameValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(ChannelNameValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return normalizeChannelName(v.ValueString()) == normalizeChannelName(newValue.ValueString()), diags
+}
+
+var channelNameSpaces = regexp.MustCompile(`\s+`)
+
+// normalizeChannelName returns name as Slack saves it: lowercased, with runs
+// of whitespace replaced by a hyphen.
+func normalizeChannelName(name string) string {
+	return channelNameSpaces.ReplaceAllString(strings.ToLower(strings.TrimSpace(name)), "-")
+}
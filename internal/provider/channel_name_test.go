@@ -0,0 +1,38 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNormalizeChannelName(t *testing.T) {
+	tests := map[string]string{
+		"general":          "general",
+		"Platform-Alerts":  "platform-alerts",
+		"Platform  Alerts": "platform-alerts",
+		" team_ops ":       "team_ops",
+	}
+
+	for name, expected := range tests {
+		if normalized := normalizeChannelName(name); normalized != expected {
+			t.Errorf("normalizeChannelName(%q) = %q, expected %q", name, normalized, expected)
+		}
+	}
+}
+
+func TestChannelNameValueSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	equal, diags := NewChannelNameValue("Platform Alerts").StringSemanticEquals(ctx, NewChannelNameValue("platform-alerts"))
+	if diags.HasError() || !equal {
+		t.Fatal("expected a name and its normalized form to be semantically equal")
+	}
+
+	equal, diags = NewChannelNameValue("Platform Alerts").StringSemanticEquals(ctx, NewChannelNameValue("platform-alarms"))
+	if diags.HasError() || equal {
+		t.Fatal("expected different names not to be semantically equal")
+	}
+}
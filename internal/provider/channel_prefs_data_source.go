@@ -0,0 +1,205 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ChannelPrefsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ChannelPrefsDataSource{}
+)
+
+func NewChannelPrefsDataSource() datasource.DataSource {
+	return &ChannelPrefsDataSource{}
+}
+
+// ChannelPrefsDataSource defines the data source implementation.
+type ChannelPrefsDataSource struct {
+	client *SlackClient
+}
+
+// ChannelPrefsDataSourceModel describes the data source data model.
+type ChannelPrefsDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	ChannelId       types.String `tfsdk:"channel_id"`
+	WhoCanPost      types.Object `tfsdk:"who_can_post"`
+	CanThread       types.Object `tfsdk:"can_thread"`
+	CanHuddle       types.Object `tfsdk:"can_huddle"`
+	EnableAtHere    types.Bool   `tfsdk:"enable_at_here"`
+	EnableAtChannel types.Bool   `tfsdk:"enable_at_channel"`
+}
+
+var channelPrefAttrTypes = map[string]attr.Type{
+	"types":    types.SetType{ElemType: types.StringType},
+	"user_ids": types.SetType{ElemType: types.StringType},
+}
+
+func (d *ChannelPrefsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_prefs"
+}
+
+func channelPrefSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description + " Null when the channel does not restrict it.",
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"types": schema.SetAttribute{
+				MarkdownDescription: "The kinds of members allowed, such as `admin`, `owner`, `regular` or `ra` (guests).",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the users allowed besides those of `types`.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (d *ChannelPrefsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets who can post, reply in threads and start huddles in a channel, and whether @here and @channel are allowed,
+such as to audit that announcement channels only let admins post.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+- ` + "`admin.conversations:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel.",
+				Computed:            true,
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel to read the preferences of.",
+				Required:            true,
+			},
+			"who_can_post": channelPrefSchema("Who can post in the channel."),
+			"can_thread":   channelPrefSchema("Who can reply in threads of the channel."),
+			"can_huddle":   channelPrefSchema("Who can start huddles in the channel."),
+			"enable_at_here": schema.BoolAttribute{
+				MarkdownDescription: "Whether members can mention `@here` in the channel. Null when Slack does not report it.",
+				Computed:            true,
+			},
+			"enable_at_channel": schema.BoolAttribute{
+				MarkdownDescription: "Whether members can mention `@channel` in the channel. Null when Slack does not report it.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *ChannelPrefsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_channel_prefs")...)
+}
+
+// channelPrefValue converts pref to an object value, null when the channel
+// does not restrict it.
+func channelPrefValue(ctx context.Context, pref *slack.AdminConversationPref) (basetypes.ObjectValue, diag.Diagnostics) {
+	if pref == nil {
+		return types.ObjectNull(channelPrefAttrTypes), nil
+	}
+
+	var diags diag.Diagnostics
+
+	prefTypes, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, pref.Type...))
+	diags.Append(d...)
+	userIds, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, pref.User...))
+	diags.Append(d...)
+
+	value, d := types.ObjectValue(channelPrefAttrTypes, map[string]attr.Value{
+		"types":    prefTypes,
+		"user_ids": userIds,
+	})
+	diags.Append(d...)
+
+	return value, diags
+}
+
+// channelPrefEnabledValue converts pref to a bool value, null when Slack did
+// not report it.
+func channelPrefEnabledValue(pref *slack.AdminConversationPrefEnabled) types.Bool {
+	if pref == nil {
+		return types.BoolNull()
+	}
+	return types.BoolValue(pref.Enabled)
+}
+
+func (d *ChannelPrefsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelPrefsDataSourceModel
+	client := d.client
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var prefs *slack.AdminConversationPrefs
+
+	err := client.retry(ctx, "admin.conversations.getConversationPrefs", func() (err error) {
+		prefs, err = client.AdminConversationsGetConversationPrefs(ctx, data.ChannelId.ValueString())
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel preferences, got error: %s", err))
+		return
+	}
+
+	// Set data from API response.
+	data.Id = data.ChannelId
+
+	var diags diag.Diagnostics
+
+	data.WhoCanPost, diags = channelPrefValue(ctx, prefs.WhoCanPost)
+	resp.Diagnostics.Append(diags...)
+	data.CanThread, diags = channelPrefValue(ctx, prefs.CanThread)
+	resp.Diagnostics.Append(diags...)
+	data.CanHuddle, diags = channelPrefValue(ctx, prefs.CanHuddle)
+	resp.Diagnostics.Append(diags...)
+
+	data.EnableAtHere = channelPrefEnabledValue(prefs.EnableAtHere)
+	data.EnableAtChannel = channelPrefEnabledValue(prefs.EnableAtChannel)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,41 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelPrefsDataSource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvChannelId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccChannelPrefsDataSourceConfig(channelId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_channel_prefs.test", "id", channelId),
+					resource.TestCheckResourceAttr("data.slack_channel_prefs.test", "channel_id", channelId),
+				),
+			},
+			{
+				Config:      providerConfig + testAccChannelPrefsDataSourceConfig("CDOESNOTEXIST"),
+				ExpectError: regexp.MustCompile(`Unable to read channel preferences`),
+			},
+		},
+	})
+}
+
+func testAccChannelPrefsDataSourceConfig(channelId string) string {
+	return `
+data "slack_channel_prefs" "test" {
+  channel_id = "` + channelId + `"
+}
+`
+}
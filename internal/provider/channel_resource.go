@@ -9,12 +9,10 @@ import (
 
 	"github.com/slack-go/slack"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
@@ -23,6 +21,9 @@ import (
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &ChannelResource{}
 var _ resource.ResourceWithImportState = &ChannelResource{}
+var _ resource.ResourceWithIdentity = &ChannelResource{}
+var _ resource.ResourceWithMoveState = &ChannelResource{}
+var _ resource.ResourceWithUpgradeState = &ChannelResource{}
 
 func NewChannelResource() resource.Resource {
 	return &ChannelResource{}
@@ -30,16 +31,16 @@ func NewChannelResource() resource.Resource {
 
 // ChannelResource defines the resource implementation.
 type ChannelResource struct {
-	client *slack.Client
+	client *SlackClient
 }
 
 // ChannelResourceModel describes the resource data model.
 type ChannelResourceModel struct {
-	Name        types.String `tfsdk:"name"`
-	Id          types.String `tfsdk:"id"`
-	IsPrivate   types.Bool   `tfsdk:"is_private"`
-	Topic       types.String `tfsdk:"topic"`
-	Description types.String `tfsdk:"description"`
+	Name        ChannelNameValue `tfsdk:"name"`
+	Id          types.String     `tfsdk:"id"`
+	IsPrivate   types.Bool       `tfsdk:"is_private"`
+	Topic       SlackTextValue   `tfsdk:"topic"`
+	Description SlackTextValue   `tfsdk:"description"`
 }
 
 func (r *ChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -48,6 +49,7 @@ func (r *ChannelResource) Metadata(ctx context.Context, req resource.MetadataReq
 
 func (r *ChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: `
 Creates a public or private slack channel.
@@ -56,8 +58,10 @@ Creates a public or private slack channel.
 `,
 		Attributes: map[string]schema.Attribute{
 			"name": schema.StringAttribute{
-				MarkdownDescription: "The name of the channel to create.",
-				Required:            true,
+				MarkdownDescription: "The name of the channel to create. " +
+					"Slack lowercases names and replaces spaces with hyphens, which is not treated as a change.",
+				CustomType: ChannelNameType{},
+				Required:   true,
 			},
 			"is_private": schema.BoolAttribute{
 				MarkdownDescription: "Create a private channel instead of a public one.",
@@ -66,16 +70,16 @@ Creates a public or private slack channel.
 				Default:             booldefault.StaticBool(false),
 			},
 			"topic": schema.StringAttribute{
-				MarkdownDescription: "The Channel's topic.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The Channel's topic. Leave unset to not manage it, or set to `\"\"` to clear it. " +
+					"Links and mentions Slack reformats are not treated as changes.",
+				CustomType: SlackTextType{},
+				Optional:   true,
 			},
 			"description": schema.StringAttribute{
-				MarkdownDescription: "The Channel's description.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "The Channel's description. Leave unset to not manage it, or set to `\"\"` to clear it. " +
+					"Links and mentions Slack reformats are not treated as changes.",
+				CustomType: SlackTextType{},
+				Optional:   true,
 			},
 			"id": schema.StringAttribute{
 				Computed:            true,
@@ -88,18 +92,22 @@ Creates a public or private slack channel.
 	}
 }
 
+func (r *ChannelResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = slackIdentitySchema("channel")
+}
+
 func (r *ChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*SlackClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *slack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -109,6 +117,9 @@ func (r *ChannelResource) Configure(ctx context.Context, req resource.ConfigureR
 }
 
 func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data ChannelResourceModel
 	client := r.client
 
@@ -120,26 +131,38 @@ func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	params := slack.CreateConversationParams{
-		ChannelName: data.Name.ValueString(),
+		ChannelName: normalizeChannelName(data.Name.ValueString()),
 		IsPrivate:   data.IsPrivate.ValueBool(),
 	}
 
-	created, err := client.CreateConversationContext(
-		ctx,
-		params,
-	)
+	var created *slack.Channel
+
+	err := client.retry(ctx, "conversations.create", func() (err error) {
+		created, err = client.CreateConversationContext(
+			ctx,
+			params,
+		)
+		return err
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create channel: %s, got error: %s", params.ChannelName, err))
 		return
 	}
 
+	// Each call below responds with the full channel, so state is composed
+	// from the latest response rather than reading the channel back again.
+	channel := created
+
 	if data.Description.ValueString() != "" {
-		tflog.Trace(ctx, "Setting channel description")
+		tflog.Trace(ctx, "Setting channel description", map[string]any{"channel_id": channel.ID})
 
-		_, err := client.SetPurposeOfConversationContext(
-			ctx, created.ID, data.Description.ValueString(),
-		)
+		err := client.retry(ctx, "conversations.setPurpose", func() (err error) {
+			channel, err = client.SetPurposeOfConversationContext(
+				ctx, created.ID, data.Description.ValueString(),
+			)
+			return err
+		})
 
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set channel description, got error: %s", err))
@@ -148,36 +171,38 @@ func (r *ChannelResource) Create(ctx context.Context, req resource.CreateRequest
 	}
 
 	if data.Topic.ValueString() != "" {
-		tflog.Trace(ctx, "Setting channel description")
+		tflog.Trace(ctx, "Setting channel topic", map[string]any{"channel_id": channel.ID})
 
-		_, err := client.SetTopicOfConversationContext(ctx, created.ID, data.Topic.ValueString())
+		err := client.retry(ctx, "conversations.setTopic", func() (err error) {
+			channel, err = client.SetTopicOfConversationContext(ctx, created.ID, data.Topic.ValueString())
+			return err
+		})
 
 		if err != nil {
-			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set channel description, got error: %s", err))
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set channel topic, got error: %s", err))
 			return
 		}
 	}
 
-	channel, err := getChannelById(ctx, client, created.ID)
-
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel, got error: %s", err))
-		return
-	}
+	client.channels.put(*channel, "")
 
 	data.Id = types.StringValue(channel.ID)
-	data.Name = types.StringValue(channel.Name)
+	data.Name = NewChannelNameValue(channel.Name)
 	data.IsPrivate = types.BoolValue(channel.IsPrivate)
-	data.Topic = types.StringValue(channel.Topic.Value)
-	data.Description = types.StringValue(channel.Purpose.Value)
+	data.Topic = managedSlackText(data.Topic, channel.Topic.Value)
+	data.Description = managedSlackText(data.Description, channel.Purpose.Value)
 
-	tflog.Trace(ctx, "Created a slack channel")
+	tflog.Trace(ctx, "Created a slack channel", map[string]any{"channel_id": channel.ID})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(client.setIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *ChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data ChannelResourceModel
 	client := r.client
 
@@ -196,16 +221,20 @@ func (r *ChannelResource) Read(ctx context.Context, req resource.ReadRequest, re
 	}
 
 	data.Id = types.StringValue(channel.ID)
-	data.Name = types.StringValue(channel.Name)
+	data.Name = NewChannelNameValue(channel.Name)
 	data.IsPrivate = types.BoolValue(channel.IsPrivate)
-	data.Topic = types.StringValue(channel.Topic.Value)
-	data.Description = types.StringValue(channel.Purpose.Value)
+	data.Topic = managedSlackText(data.Topic, channel.Topic.Value)
+	data.Description = managedSlackText(data.Description, channel.Purpose.Value)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(client.setIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var plan, state ChannelResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -217,12 +246,20 @@ func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	if !plan.Name.Equal(state.Name) {
+	// Each call below responds with the full channel, so state is composed
+	// from the latest response and the channel is only read back when
+	// nothing needed to change.
+	var channel *slack.Channel
+
+	if normalizeChannelName(plan.Name.ValueString()) != normalizeChannelName(state.Name.ValueString()) {
 		tflog.Trace(ctx, "Updating Channel Name")
 
-		_, err := client.RenameConversationContext(
-			ctx, state.Id.ValueString(), plan.Name.ValueString(),
-		)
+		err := client.retry(ctx, "conversations.rename", func() (err error) {
+			channel, err = client.RenameConversationContext(
+				ctx, state.Id.ValueString(), normalizeChannelName(plan.Name.ValueString()),
+			)
+			return err
+		})
 
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update channel name, got error: %s", err))
@@ -230,12 +267,16 @@ func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	if !plan.Description.Equal(state.Description) {
+	// Unset values are not managed, so only configured changes are applied.
+	if !plan.Description.IsNull() && !plan.Description.Equal(state.Description) {
 		tflog.Trace(ctx, "Updating Channel Description")
 
-		_, err := client.SetPurposeOfConversationContext(
-			ctx, state.Id.ValueString(), plan.Description.ValueString(),
-		)
+		err := client.retry(ctx, "conversations.setPurpose", func() (err error) {
+			channel, err = client.SetPurposeOfConversationContext(
+				ctx, state.Id.ValueString(), plan.Description.ValueString(),
+			)
+			return err
+		})
 
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update channel description, got error: %s", err))
@@ -243,12 +284,15 @@ func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	if !plan.Topic.Equal(state.Topic) {
+	if !plan.Topic.IsNull() && !plan.Topic.Equal(state.Topic) {
 		tflog.Trace(ctx, "Updating Channel Topic")
 
-		_, err := client.SetTopicOfConversationContext(
-			ctx, state.Id.ValueString(), plan.Topic.ValueString(),
-		)
+		err := client.retry(ctx, "conversations.setTopic", func() (err error) {
+			channel, err = client.SetTopicOfConversationContext(
+				ctx, state.Id.ValueString(), plan.Topic.ValueString(),
+			)
+			return err
+		})
 
 		if err != nil {
 			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update channel topic, got error: %s", err))
@@ -256,22 +300,31 @@ func (r *ChannelResource) Update(ctx context.Context, req resource.UpdateRequest
 		}
 	}
 
-	channel, err := getChannelById(ctx, client, state.Id.ValueString())
+	if channel == nil {
+		current, err := getChannelById(ctx, client, state.Id.ValueString())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel, got error: %s", err))
-		return
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel, got error: %s", err))
+			return
+		}
+		channel = &current
 	}
 
-	plan.Name = types.StringValue(channel.Name)
+	client.channels.put(*channel, normalizeChannelName(state.Name.ValueString()))
+
+	plan.Name = NewChannelNameValue(channel.Name)
 	plan.IsPrivate = types.BoolValue(channel.IsPrivate)
-	plan.Topic = types.StringValue(channel.Topic.Value)
-	plan.Description = types.StringValue(channel.Purpose.Value)
+	plan.Topic = managedSlackText(plan.Topic, channel.Topic.Value)
+	plan.Description = managedSlackText(plan.Description, channel.Purpose.Value)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(client.setIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data ChannelResourceModel
 	client := r.client
 
@@ -282,11 +335,16 @@ func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest
 		return
 	}
 
-	err := client.ArchiveConversationContext(
-		ctx, data.Id.ValueString(),
-	)
+	err := client.retry(ctx, "conversations.archive", func() error {
+		return client.ArchiveConversationContext(
+			ctx, data.Id.ValueString(),
+		)
+	})
 	if err != nil {
-		if err.Error() == "channel_not_found" {
+		// The channel may have been archived out of band, or by a parallel
+		// destroy, since it was last read.
+		switch err.Error() {
+		case "channel_not_found", "already_archived", "is_archived":
 			return
 		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to archive channel, got error: %s", err))
@@ -296,5 +354,70 @@ func (r *ChannelResource) Delete(ctx context.Context, req resource.DeleteRequest
 }
 
 func (r *ChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	r.client.importStateWithIdentity(ctx, req, resp)
+}
+
+// communityConversationState describes the parts of the state of a
+// pablovarela/slack slack_conversation that carry over to a slack_channel.
+type communityConversationState struct {
+	Id        string `json:"id"`
+	Name      string `json:"name"`
+	IsPrivate bool   `json:"is_private"`
+	Topic     string `json:"topic"`
+	Purpose   string `json:"purpose"`
+}
+
+func (r *ChannelResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored an unset topic and description as "".
+		0: unsetEmptyStringsUpgrader(schemaResp.Schema, "topic", "description"),
+	}
+}
+
+func (r *ChannelResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !movesFromCommunityProvider(req, "slack_conversation") {
+					return
+				}
+
+				var source communityConversationState
+
+				resp.Diagnostics.Append(decodeSourceState(req, &source)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// This provider does not manage channel members, so
+				// permanent_members does not carry over.
+				data := ChannelResourceModel{
+					Id:          types.StringValue(source.Id),
+					Name:        NewChannelNameValue(source.Name),
+					IsPrivate:   types.BoolValue(source.IsPrivate),
+					Topic:       SlackTextValue{StringValue: optionalString(source.Topic)},
+					Description: SlackTextValue{StringValue: optionalString(source.Purpose)},
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+
+				if r.client != nil {
+					resp.Diagnostics.Append(r.client.setIdentity(ctx, resp.TargetIdentity, source.Id)...)
+				}
+			},
+		},
+	}
+}
+
+// managedSlackText returns value as the new value of an attribute whose
+// current value is current, unless it is null and so not managed.
+func managedSlackText(current SlackTextValue, value string) SlackTextValue {
+	if current.IsNull() {
+		return current
+	}
+	return NewSlackTextValue(value)
 }
@@ -4,16 +4,54 @@
 package provider
 
 import (
+	"context"
+	"fmt"
+	"regexp"
 	"testing"
 	"time"
 
 	"math/rand"
 
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
 )
 
 func init() {
 	rand.New(rand.NewSource(time.Now().UnixNano()))
+
+	resource.AddTestSweepers("slack_channel", &resource.Sweeper{
+		Name: "slack_channel",
+		F:    sweepChannels,
+	})
+}
+
+// testSweepChannelName matches the channels created by TestChannelResource.
+var testSweepChannelName = regexp.MustCompile(`^test-channel-[a-z]{6}$`)
+
+func sweepChannels(_ string) error {
+	ctx := context.Background()
+	client := sweeperClient()
+
+	channels, err := listChannels(ctx, client, []string{"public_channel", "private_channel"})
+	if err != nil {
+		return err
+	}
+
+	for _, channel := range channels {
+		if channel.IsArchived || !testSweepChannelName.MatchString(channel.Name) {
+			continue
+		}
+
+		err := client.retry(ctx, "conversations.archive", func() error {
+			return client.ArchiveConversationContext(ctx, channel.ID)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to archive channel %s (%s): %s", channel.Name, channel.ID, err)
+		}
+	}
+
+	return nil
 }
 
 var letters = []rune("abcdefghijklmnopqrstuvwxyz")
@@ -26,14 +64,14 @@ func randSeq(n int) string {
 	return string(b)
 }
 
-var testResourceNameSuffix string = randSeq(6)
-var testChannelName string = "test-channel-" + testResourceNameSuffix
-var testChannelDescription string = "Test Description " + testResourceNameSuffix
-var testChannelTopic string = "Test Topic " + testResourceNameSuffix
-
 func TestChannelResource(t *testing.T) {
+	testResourceNameSuffix := testAccNameSuffix(t)
+	testChannelName := "test-channel-" + testResourceNameSuffix
+	testChannelDescription := "Test Description " + testResourceNameSuffix
+	testChannelTopic := "Test Topic " + testResourceNameSuffix
+
 	resource.Test(t, resource.TestCase{
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviders(t),
 		Steps: []resource.TestStep{
 			// Create and Read testing
 			{
@@ -44,9 +82,15 @@ resource "slack_channel" "test" {
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("slack_channel.test", "name", testChannelName),
-					resource.TestCheckResourceAttr("slack_channel.test", "description", ""),
-					resource.TestCheckResourceAttr("slack_channel.test", "topic", ""),
+					resource.TestCheckNoResourceAttr("slack_channel.test", "description"),
+					resource.TestCheckNoResourceAttr("slack_channel.test", "topic"),
 				),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectIdentity("slack_channel.test", map[string]knownvalue.Check{
+						"id":      knownvalue.NotNull(),
+						"team_id": knownvalue.NotNull(),
+					}),
+				},
 			},
 			// ImportState testing
 			{
@@ -54,6 +98,12 @@ resource "slack_channel" "test" {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			// Import by resource identity
+			{
+				ResourceName:    "slack_channel.test",
+				ImportState:     true,
+				ImportStateKind: resource.ImportBlockWithResourceIdentity,
+			},
 			// Update and Read testing
 			{
 				Config: providerConfig + `
@@ -69,12 +119,27 @@ resource "slack_channel" "test" {
 					resource.TestCheckResourceAttr("slack_channel.test", "topic", testChannelTopic),
 				),
 			},
-			// Test Removal of Topic and Desc values
+			// Unset Topic and Desc values are left as they are
 			{
 				Config: providerConfig + `
 resource "slack_channel" "test" {
   name = "` + testChannelName + `"
 }
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_channel.test", "name", testChannelName),
+					resource.TestCheckNoResourceAttr("slack_channel.test", "description"),
+					resource.TestCheckNoResourceAttr("slack_channel.test", "topic"),
+				),
+			},
+			// Test Removal of Topic and Desc values
+			{
+				Config: providerConfig + `
+resource "slack_channel" "test" {
+  name        = "` + testChannelName + `"
+  description = ""
+  topic       = ""
+}
 `,
 				Check: resource.ComposeAggregateTestCheckFunc(
 					resource.TestCheckResourceAttr("slack_channel.test", "name", testChannelName),
@@ -86,3 +151,15 @@ resource "slack_channel" "test" {
 		},
 	})
 }
+
+func TestChannelResourceDeleteArchivedOutOfBand(t *testing.T) {
+	m := newMockSlack()
+	m.channels[mockChannelId].IsArchived = true
+
+	if diags := m.destroy(t, &ChannelResource{}, mockChannelId); diags.HasError() {
+		t.Fatalf("expected destroying an archived channel to succeed, got: %v", diags)
+	}
+	if diags := m.destroy(t, &ChannelResource{}, "C0MOCKDELETED"); diags.HasError() {
+		t.Fatalf("expected destroying a deleted channel to succeed, got: %v", diags)
+	}
+}
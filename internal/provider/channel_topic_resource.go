@@ -0,0 +1,228 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChannelTopicResource{}
+var _ resource.ResourceWithImportState = &ChannelTopicResource{}
+
+func NewChannelTopicResource() resource.Resource {
+	return &ChannelTopicResource{}
+}
+
+// ChannelTopicResource defines the resource implementation.
+type ChannelTopicResource struct {
+	client *SlackClient
+}
+
+// ChannelTopicResourceModel describes the resource data model.
+type ChannelTopicResourceModel struct {
+	Id        types.String   `tfsdk:"id"`
+	ChannelId types.String   `tfsdk:"channel_id"`
+	Topic     SlackTextValue `tfsdk:"topic"`
+}
+
+func (r *ChannelTopicResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_topic"
+}
+
+func (r *ChannelTopicResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Manages the topic of an existing channel, without managing the channel itself, such as to keep the current on-call engineer in it.
+The topic is cleared on destroy. Channels managed with ` + "`slack_channel`" + ` should leave its ` + "`topic`" + ` unset.
+### Required Permissions
+` + "- `channels:write.topic` (For public channels)" + `
+` + "- `groups:write.topic` (For private channels)" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the channel to manage the topic of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"topic": schema.StringAttribute{
+				MarkdownDescription: "The channel's topic. Links and mentions Slack reformats are not treated as changes.",
+				CustomType:          SlackTextType{},
+				Required:            true,
+			},
+		},
+	}
+}
+
+func (r *ChannelTopicResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// setTopic sets the topic of the channel with the given ID, returning the
+// topic as Slack saved it.
+func (r *ChannelTopicResource) setTopic(ctx context.Context, channelId string, topic string) (string, error) {
+	client := r.client
+
+	var saved string
+
+	err := client.retry(ctx, "conversations.setTopic", func() error {
+		channel, err := client.SetTopicOfConversationContext(ctx, channelId, topic)
+		if err == nil {
+			saved = channel.Topic.Value
+		}
+		return err
+	})
+
+	return saved, err
+}
+
+func (r *ChannelTopicResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelTopicResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topic, err := r.setTopic(ctx, data.ChannelId.ValueString(), data.Topic.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set topic of channel: %s, got error: %s", data.ChannelId.ValueString(), err))
+		return
+	}
+
+	data.Id = data.ChannelId
+	data.Topic = NewSlackTextValue(topic)
+
+	tflog.Trace(ctx, "Set the topic of a slack channel", map[string]any{"channel_id": data.ChannelId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelTopicResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelTopicResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channel, err := getChannelById(ctx, client, data.Id.ValueString())
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel, got error: %s", err))
+		return
+	}
+
+	data.ChannelId = types.StringValue(channel.ID)
+	data.Topic = NewSlackTextValue(channel.Topic.Value)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelTopicResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan ChannelTopicResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	topic, err := r.setTopic(ctx, plan.Id.ValueString(), plan.Topic.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update topic of channel: %s, got error: %s", plan.Id.ValueString(), err))
+		return
+	}
+
+	plan.Topic = NewSlackTextValue(topic)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelTopicResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelTopicResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := r.setTopic(ctx, data.Id.ValueString(), "")
+
+	if err != nil {
+		switch err.Error() {
+		case "channel_not_found", "is_archived":
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear channel topic, got error: %s", err))
+		return
+	}
+}
+
+func (r *ChannelTopicResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+}
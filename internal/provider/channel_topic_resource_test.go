@@ -0,0 +1,62 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelTopicResource(t *testing.T) {
+	testChannelName := "test-channel-" + testAccNameSuffix(t)
+
+	channelConfig := providerConfig + `
+resource "slack_channel" "test" {
+  name = "` + testChannelName + `"
+}
+`
+	topicConfig := func(topic string) string {
+		return channelConfig + `
+resource "slack_channel_topic" "test" {
+  channel_id = slack_channel.test.id
+  topic      = "` + topic + `"
+}
+`
+	}
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: topicConfig("On call: <@U0123ABCDEF>"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("slack_channel_topic.test", "id", "slack_channel.test", "id"),
+					resource.TestCheckResourceAttr("slack_channel_topic.test", "topic", "On call: <@U0123ABCDEF>"),
+				),
+			},
+			// Update and Read testing
+			{
+				Config: topicConfig("On call: nobody"),
+				Check:  resource.TestCheckResourceAttr("slack_channel_topic.test", "topic", "On call: nobody"),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_channel_topic.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Destroying the resource clears the topic
+			{
+				Config: channelConfig + `
+data "slack_channel" "test" {
+  id = slack_channel.test.id
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.slack_channel.test", "topic", ""),
+			},
+		},
+	})
+}
@@ -0,0 +1,273 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ChannelWorkspacesResource{}
+var _ resource.ResourceWithImportState = &ChannelWorkspacesResource{}
+
+func NewChannelWorkspacesResource() resource.Resource {
+	return &ChannelWorkspacesResource{}
+}
+
+// ChannelWorkspacesResource defines the resource implementation.
+type ChannelWorkspacesResource struct {
+	client *SlackClient
+}
+
+// ChannelWorkspacesResourceModel describes the resource data model.
+type ChannelWorkspacesResourceModel struct {
+	Id            types.String `tfsdk:"id"`
+	ChannelId     types.String `tfsdk:"channel_id"`
+	TargetTeamIds types.Set    `tfsdk:"target_team_ids"`
+}
+
+func (r *ChannelWorkspacesResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_channel_workspaces"
+}
+
+func (r *ChannelWorkspacesResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Sets the workspaces of an Enterprise Grid organization a channel belongs to with ` + "`admin.conversations.setTeams`" + `,
+such as to move a channel to another workspace when teams are restructured.
+Slack cannot undo a move, so destroying the resource leaves the channel's workspaces as they are.
+### Required Permissions
+- An org-level user token of an Org Admin or Owner.
+` + "- `admin.conversations:read`" + `
+` + "- `admin.conversations:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel to set the workspaces of.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"target_team_ids": schema.SetAttribute{
+				MarkdownDescription: "The IDs of the workspaces the channel belongs to. Workspaces left out are removed from the channel.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+		},
+	}
+}
+
+func (r *ChannelWorkspacesResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_channel_workspaces")...)
+}
+
+func (r *ChannelWorkspacesResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelWorkspacesResourceModel
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setChannelTeams(ctx, r.client, data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = data.ChannelId
+
+	tflog.Trace(ctx, "Set the workspaces of a slack channel", map[string]any{"channel_id": data.ChannelId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelWorkspacesResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ChannelWorkspacesResourceModel
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	teamIds, err := getChannelTeams(ctx, r.client, data.ChannelId.ValueString())
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read channel workspaces, got error: %s", err))
+		return
+	}
+
+	targetTeamIds, diags := types.SetValueFrom(ctx, types.StringType, teamIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.Id = data.ChannelId
+	data.TargetTeamIds = targetTeamIds
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ChannelWorkspacesResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan ChannelWorkspacesResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(setChannelTeams(ctx, r.client, plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ChannelWorkspacesResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Slack has no record of the workspaces a channel belonged to before, so
+	// the channel is left where it is.
+	tflog.Trace(ctx, "Leaving the workspaces of a slack channel as they are")
+}
+
+func (r *ChannelWorkspacesResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), req.ID)...)
+}
+
+// setChannelTeams sets the workspaces of the channel in data.
+func setChannelTeams(ctx context.Context, client *SlackClient, data ChannelWorkspacesResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+	var targetTeamIds []string
+
+	diags.Append(data.TargetTeamIds.ElementsAs(ctx, &targetTeamIds, false)...)
+
+	if diags.HasError() {
+		return diags
+	}
+
+	// Slack needs the workspace a channel belongs to, unless it is shared
+	// between several.
+	current, err := getChannelTeams(ctx, client, data.ChannelId.ValueString())
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to read channel workspaces, got error: %s", err))
+		return diags
+	}
+
+	params := slack.AdminConversationsSetTeamsParams{
+		ChannelID:     data.ChannelId.ValueString(),
+		TargetTeamIDs: targetTeamIds,
+	}
+	if len(current) == 1 {
+		params.TeamID = &current[0]
+	}
+
+	err = client.retry(ctx, "admin.conversations.setTeams", func() error {
+		return client.AdminConversationsSetTeams(ctx, params)
+	})
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to set channel workspaces, got error: %s", err))
+	}
+
+	return diags
+}
+
+// getChannelTeams pages through admin.conversations.getTeams and returns the
+// IDs of every workspace the channel belongs to, sorted.
+func getChannelTeams(ctx context.Context, client *SlackClient, channelId string) ([]string, error) {
+	var teamIds []string
+	var cursor string
+
+	for {
+		var page []string
+		var next string
+
+		err := client.retry(ctx, "admin.conversations.getTeams", func() (err error) {
+			page, next, err = client.AdminConversationsGetTeams(ctx, slack.AdminConversationsGetTeamsParams{
+				ChannelID: channelId,
+				Cursor:    cursor,
+				Limit:     100,
+			})
+			return err
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		teamIds = append(teamIds, page...)
+
+		if next == "" {
+			slices.Sort(teamIds)
+			return teamIds, nil
+		}
+		cursor = next
+	}
+}
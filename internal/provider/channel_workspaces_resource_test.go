@@ -0,0 +1,60 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccChannelWorkspacesResource(t *testing.T) {
+	teamId := testAccFixture(t, testEnvAssignTeamId)
+	channelName := "test-channel-" + testAccNameSuffix(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccChannelWorkspacesResourceConfig(channelName, `[data.slack_token_scopes.self.team_id, "`+teamId+`"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("slack_channel_workspaces.test", "id", "slack_channel.test", "id"),
+					resource.TestCheckResourceAttr("slack_channel_workspaces.test", "target_team_ids.#", "2"),
+					resource.TestCheckTypeSetElemAttr("slack_channel_workspaces.test", "target_team_ids.*", teamId),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_channel_workspaces.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Move the channel to the other workspace only
+			{
+				Config: providerConfig + testAccChannelWorkspacesResourceConfig(channelName, `["`+teamId+`"]`),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_channel_workspaces.test", "target_team_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("slack_channel_workspaces.test", "target_team_ids.*", teamId),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccChannelWorkspacesResourceConfig(channelName string, targetTeamIds string) string {
+	return `
+data "slack_token_scopes" "self" {}
+
+resource "slack_channel" "test" {
+  name = "` + channelName + `"
+}
+
+resource "slack_channel_workspaces" "test" {
+  channel_id      = slack_channel.test.id
+  target_team_ids = ` + targetTeamIds + `
+}
+`
+}
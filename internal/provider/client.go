@@ -0,0 +1,368 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"math/rand/v2"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// SlackClient is the provider data handed to every resource and data source.
+// It embeds the Slack API client and carries state shared for the lifetime
+// of the provider, such as the channel name index.
+type SlackClient struct {
+	*slack.Client
+
+	channels *channelIndexes
+
+	// teamId is the workspace the token belongs to, recorded in resource
+	// identities.
+	teamId string
+
+	// token, apiURL and httpClient are those the Slack API client was built
+	// with, for calls made outside of it such as OAuth token exchanges and
+	// methods it does not implement. apiURL is empty when the default Slack
+	// API is used.
+	token      string
+	apiURL     string
+	httpClient *http.Client
+
+	// tokenType is the kind of token the client was built with, such as
+	// tokenTypeBot or tokenTypeUser.
+	tokenType string
+
+	// userId is the user the token acts as, and scopes the OAuth scopes
+	// granted to it, both as reported by auth.test.
+	userId string
+	scopes []string
+
+	// cache caches the listings data sources search across Terraform runs.
+	// It is nil when caching is disabled.
+	cache *readCache
+
+	// adminChannelSearch finds channels by name with admin.conversations.search
+	// rather than the channel name index.
+	adminChannelSearch bool
+
+	// checkUserGroupConflicts checks the names and handles of User Groups for
+	// conflicts with the workspace when planning.
+	checkUserGroupConflicts bool
+
+	// redactEmails leaves the email addresses data sources read null.
+	redactEmails bool
+
+	// retryOn are the Slack error codes calls are retried on, besides rate
+	// limits, such as internal_error.
+	retryOn []string
+
+	// retryMaxElapsed bounds how long a single call is retried for. It is
+	// zero when calls are retried for as long as Slack asks.
+	retryMaxElapsed time.Duration
+
+	// userGroupLocks serializes changes to the default channels of each User
+	// Group, which can only be replaced as a whole.
+	userGroupLocksMu sync.Mutex
+	userGroupLocks   map[string]*sync.Mutex
+}
+
+func NewSlackClient(client *slack.Client) *SlackClient {
+	return &SlackClient{
+		Client:   client,
+		channels: newChannelIndexes(),
+	}
+}
+
+// lockUserGroup locks the User Group with the given ID against concurrent
+// changes of its default channels, returning the function that unlocks it.
+func (c *SlackClient) lockUserGroup(id string) func() {
+	c.userGroupLocksMu.Lock()
+	if c.userGroupLocks == nil {
+		c.userGroupLocks = map[string]*sync.Mutex{}
+	}
+	mu, ok := c.userGroupLocks[id]
+	if !ok {
+		mu = &sync.Mutex{}
+		c.userGroupLocks[id] = mu
+	}
+	c.userGroupLocksMu.Unlock()
+
+	mu.Lock()
+	return mu.Unlock
+}
+
+// retryOnAttempts is how many times a call failing with one of the errors of
+// retry_on is retried.
+const retryOnAttempts = 5
+
+// retryBackoff is the backoff before the first retry of a call, which doubles
+// with every retry after, up to retryMaxBackoff.
+var retryBackoff = time.Second
+
+const retryMaxBackoff = time.Minute
+
+// backoff returns the exponential backoff before the given retry of a call.
+func backoff(retries int) time.Duration {
+	// Stop shifting well before the backoff could overflow.
+	return min(retryBackoff<<min(retries, 16), retryMaxBackoff)
+}
+
+// jitter returns a random duration of up to d, so calls that are retried at
+// the same moment, such as those of many resources rate limited together,
+// spread out rather than being retried at the same moment again.
+func jitter(d time.Duration) time.Duration {
+	if d <= 0 {
+		return 0
+	}
+	return rand.N(d)
+}
+
+// retry runs a single Slack API call, waiting out and repeating it for as long
+// as Slack responds with a rate limit error, and with backoff while it fails
+// with one of the errors of retry_on. Rate limits are waited out for as long
+// as Slack's Retry-After asks, plus a jitter that grows with every retry.
+// Calls are given up on once retrying them would take longer than
+// retry_max_elapsed. endpoint names the API method being called and is used
+// for logging.
+func (c *SlackClient) retry(ctx context.Context, endpoint string, call func() error) error {
+	start := time.Now()
+	errorRetries := 0
+
+	for retries := 0; ; retries++ {
+		err := call()
+
+		var wait time.Duration
+		if rateLimitedError, ok := err.(*slack.RateLimitedError); ok {
+			wait = rateLimitedError.RetryAfter + jitter(backoff(retries))
+		} else if err != nil && errorRetries < retryOnAttempts && slices.Contains(c.retryOn, err.Error()) {
+			// Wait at least half of the backoff, so retries still back off
+			// however the jitter falls.
+			wait = backoff(errorRetries)/2 + jitter(backoff(errorRetries)/2)
+			errorRetries++
+		} else {
+			fields := map[string]any{
+				"endpoint":    endpoint,
+				"duration_ms": time.Since(start).Milliseconds(),
+				"retries":     retries,
+			}
+			if err != nil {
+				fields["error"] = err.Error()
+			}
+			tflog.Trace(ctx, "Called Slack API", fields)
+
+			return err
+		}
+
+		if elapsed := time.Since(start); c.retryMaxElapsed > 0 && elapsed+wait > c.retryMaxElapsed {
+			tflog.Error(ctx, "Gave up retrying Slack API call", map[string]any{
+				"endpoint": endpoint,
+				"retries":  retries,
+				"elapsed":  elapsed.String(),
+				"error":    err.Error(),
+			})
+			return fmt.Errorf("gave up retrying %s after %s: %w", endpoint, elapsed.Round(time.Second), err)
+		}
+
+		if _, ok := err.(*slack.RateLimitedError); ok {
+			recordRateLimit(ctx, endpoint, wait)
+		} else {
+			tflog.Warn(ctx, "Retrying Slack API call after error", map[string]any{
+				"endpoint":    endpoint,
+				"error":       err.Error(),
+				"retry_after": wait.String(),
+				"retries":     errorRetries,
+			})
+		}
+
+		select {
+		case <-ctx.Done():
+			tflog.Error(ctx, "Gave up retrying Slack API call", map[string]any{
+				"endpoint": endpoint,
+				"retries":  retries,
+				"error":    ctx.Err().Error(),
+			})
+			return ctx.Err()
+		case <-time.After(wait):
+		}
+	}
+}
+
+// apiCall calls a Slack API method the Slack API client does not implement,
+// decoding the response into response, which should embed
+// slack.SlackResponse. Errors match those of the Slack API client, so calls
+// can be wrapped in retry.
+func (c *SlackClient) apiCall(ctx context.Context, method string, values url.Values, response interface{ Err() error }) error {
+	return c.apiCallWithToken(ctx, c.token, method, values, response)
+}
+
+// apiCallWithToken is apiCall authenticated with the given token rather than
+// the provider's, such as an app configuration token.
+func (c *SlackClient) apiCallWithToken(ctx context.Context, token string, method string, values url.Values, response interface{ Err() error }) error {
+	apiURL := c.apiURL
+	if apiURL == "" {
+		apiURL = slack.APIURL
+	}
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, apiURL+method, strings.NewReader(values.Encode()))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	req.Header.Set("Authorization", "Bearer "+token)
+
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		// Read the body to the end, so the connection can be reused.
+		_, _ = io.Copy(io.Discard, resp.Body)
+		resp.Body.Close()
+	}()
+
+	if resp.StatusCode == http.StatusTooManyRequests && resp.Header.Get("Retry-After") != "" {
+		retryAfter, err := strconv.ParseInt(resp.Header.Get("Retry-After"), 10, 64)
+		if err != nil {
+			return err
+		}
+		return &slack.RateLimitedError{RetryAfter: time.Duration(retryAfter) * time.Second}
+	}
+	if resp.StatusCode != http.StatusOK {
+		return slack.StatusCodeError{Code: resp.StatusCode, Status: resp.Status}
+	}
+
+	if err := json.NewDecoder(resp.Body).Decode(response); err != nil {
+		return err
+	}
+	if receiver, ok := response.(responseHeaderReceiver); ok {
+		receiver.receiveHeader(resp.Header)
+	}
+	return response.Err()
+}
+
+// responseHeaderReceiver is implemented by responses of apiCall that need
+// some of the HTTP response headers, which the Slack API client discards.
+type responseHeaderReceiver interface {
+	receiveHeader(header http.Header)
+}
+
+type authTestResponse struct {
+	slack.SlackResponse
+	Team         string `json:"team"`
+	TeamID       string `json:"team_id"`
+	EnterpriseID string `json:"enterprise_id"`
+	UserID       string `json:"user_id"`
+
+	// Scopes are the OAuth scopes granted to the token, which Slack only
+	// reports in the X-OAuth-Scopes header.
+	Scopes []string `json:"-"`
+}
+
+func (r *authTestResponse) receiveHeader(header http.Header) {
+	for _, scope := range strings.Split(header.Get("X-OAuth-Scopes"), ",") {
+		if scope = strings.TrimSpace(scope); scope != "" {
+			r.Scopes = append(r.Scopes, scope)
+		}
+	}
+}
+
+// authTest calls auth.test, which the Slack API client implements without
+// the scopes granted to the token.
+func (c *SlackClient) authTest(ctx context.Context) (*authTestResponse, error) {
+	response := &authTestResponse{}
+	if err := c.apiCall(ctx, "auth.test", url.Values{}, response); err != nil {
+		return nil, err
+	}
+	return response, nil
+}
+
+// rateLimitWaits records the time retry spent waiting out rate limits during
+// a single operation, per endpoint.
+type rateLimitWaits struct {
+	mu        sync.Mutex
+	endpoints []string
+	waits     map[string]time.Duration
+	retries   map[string]int
+}
+
+type rateLimitWaitsKey struct{}
+
+// trackRateLimits returns a context in which retry records the rate limits it
+// waits out, and a function that adds a warning summarizing them to diags, so
+// users can tell why an apply is slow. It is meant to be deferred at the start
+// of each operation:
+//
+//	ctx, reportRateLimits := trackRateLimits(ctx)
+//	defer reportRateLimits(&resp.Diagnostics)
+func trackRateLimits(ctx context.Context) (context.Context, func(diags *diag.Diagnostics)) {
+	waits := &rateLimitWaits{
+		waits:   map[string]time.Duration{},
+		retries: map[string]int{},
+	}
+
+	return context.WithValue(ctx, rateLimitWaitsKey{}, waits), func(diags *diag.Diagnostics) {
+		waits.mu.Lock()
+		defer waits.mu.Unlock()
+
+		if len(waits.endpoints) == 0 {
+			return
+		}
+
+		details := make([]string, 0, len(waits.endpoints))
+		for _, endpoint := range waits.endpoints {
+			details = append(details, fmt.Sprintf("- %s: waited %s over %d retries", endpoint, waits.waits[endpoint], waits.retries[endpoint]))
+		}
+
+		diags.AddWarning(
+			"Rate Limited by Slack",
+			"Slack rate limited the following API methods, and the provider waited before retrying them:\n\n"+
+				strings.Join(details, "\n")+"\n\n"+
+				"Looking up many channels, users or User Groups by name, or managing many resources of the same kind, adds to this. "+
+				"Referring to them by ID, or lowering -parallelism, can make applies faster.",
+		)
+	}
+}
+
+// recordRateLimit records a rate limit wait for the operation tracking them in
+// ctx, if any.
+func recordRateLimit(ctx context.Context, endpoint string, wait time.Duration) {
+	waits, ok := ctx.Value(rateLimitWaitsKey{}).(*rateLimitWaits)
+	if !ok {
+		return
+	}
+
+	waits.mu.Lock()
+	defer waits.mu.Unlock()
+
+	if _, seen := waits.waits[endpoint]; !seen {
+		waits.endpoints = append(waits.endpoints, endpoint)
+	}
+	waits.waits[endpoint] += wait
+	waits.retries[endpoint]++
+
+	tflog.Warn(ctx, "Rate limited by Slack", map[string]any{
+		"endpoint":    endpoint,
+		"retry_after": wait.String(),
+		"total_wait":  waits.waits[endpoint].String(),
+		"retries":     waits.retries[endpoint],
+	})
+}
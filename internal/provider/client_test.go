@@ -0,0 +1,143 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+func TestTrackRateLimits(t *testing.T) {
+	ctx, reportRateLimits := trackRateLimits(context.Background())
+
+	recordRateLimit(ctx, "conversations.list", 20*time.Second)
+	recordRateLimit(ctx, "users.list", 5*time.Second)
+	recordRateLimit(ctx, "conversations.list", 10*time.Second)
+
+	var diags diag.Diagnostics
+	reportRateLimits(&diags)
+
+	if diags.WarningsCount() != 1 || diags.HasError() {
+		t.Fatalf("expected a single warning, got: %v", diags)
+	}
+
+	expected := "- conversations.list: waited 30s over 2 retries\n- users.list: waited 5s over 1 retries"
+	if detail := diags[0].Detail(); !strings.Contains(detail, expected) {
+		t.Fatalf("expected warning to contain %q, got: %s", expected, detail)
+	}
+}
+
+func TestTrackRateLimitsNotLimited(t *testing.T) {
+	_, reportRateLimits := trackRateLimits(context.Background())
+
+	// Waits recorded outside of a tracked operation are ignored.
+	recordRateLimit(context.Background(), "conversations.list", time.Second)
+
+	var diags diag.Diagnostics
+	reportRateLimits(&diags)
+
+	if len(diags) != 0 {
+		t.Fatalf("expected no diagnostics, got: %v", diags)
+	}
+}
+
+func TestAuthTestScopes(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.Header().Set("X-OAuth-Scopes", "users:read, channels:read,usergroups:write")
+		fmt.Fprint(w, `{"ok": true, "team_id": "T1", "user_id": "U1"}`)
+	}))
+	defer server.Close()
+
+	client := &SlackClient{apiURL: server.URL + "/"}
+	auth, err := client.authTest(context.Background())
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	expected := []string{"users:read", "channels:read", "usergroups:write"}
+	if auth.TeamID != "T1" || auth.UserID != "U1" || !slices.Equal(auth.Scopes, expected) {
+		t.Fatalf("expected team T1, user U1 and scopes %v, got: %+v", expected, auth)
+	}
+}
+
+func TestRetryOn(t *testing.T) {
+	defer func(backoff time.Duration) { retryBackoff = backoff }(retryBackoff)
+	retryBackoff = time.Millisecond
+
+	client := &SlackClient{retryOn: []string{"internal_error"}}
+
+	calls := 0
+	err := client.retry(context.Background(), "conversations.info", func() error {
+		calls++
+		if calls < 3 {
+			return slack.SlackErrorResponse{Err: "internal_error"}
+		}
+		return nil
+	})
+	if err != nil || calls != 3 {
+		t.Fatalf("expected the call to succeed on the third attempt, got %d calls and error: %v", calls, err)
+	}
+
+	calls = 0
+	err = client.retry(context.Background(), "conversations.info", func() error {
+		calls++
+		return slack.SlackErrorResponse{Err: "internal_error"}
+	})
+	if err == nil || calls != retryOnAttempts+1 {
+		t.Fatalf("expected to give up after %d retries, got %d calls and error: %v", retryOnAttempts, calls, err)
+	}
+
+	calls = 0
+	err = client.retry(context.Background(), "conversations.info", func() error {
+		calls++
+		return slack.SlackErrorResponse{Err: "channel_not_found"}
+	})
+	if err == nil || calls != 1 {
+		t.Fatalf("expected other errors not to be retried, got %d calls and error: %v", calls, err)
+	}
+}
+
+func TestRetryMaxElapsed(t *testing.T) {
+	client := &SlackClient{retryMaxElapsed: time.Minute}
+
+	calls := 0
+	err := client.retry(context.Background(), "conversations.info", func() error {
+		calls++
+		return &slack.RateLimitedError{RetryAfter: time.Hour}
+	})
+
+	var rateLimitedError *slack.RateLimitedError
+	if !errors.As(err, &rateLimitedError) || calls != 1 {
+		t.Fatalf("expected to give up rather than wait past the deadline, got %d calls and error: %v", calls, err)
+	}
+}
+
+func TestBackoff(t *testing.T) {
+	if backoff(0) != retryBackoff || backoff(1) != 2*retryBackoff {
+		t.Fatalf("expected the backoff to double, got: %s, %s", backoff(0), backoff(1))
+	}
+	if backoff(100) != retryMaxBackoff {
+		t.Fatalf("expected the backoff to be capped at %s, got: %s", retryMaxBackoff, backoff(100))
+	}
+
+	for range 100 {
+		if wait := jitter(time.Second); wait < 0 || wait >= time.Second {
+			t.Fatalf("expected a jitter below 1s, got: %s", wait)
+		}
+	}
+	if jitter(0) != 0 {
+		t.Fatal("expected no jitter without a backoff")
+	}
+}
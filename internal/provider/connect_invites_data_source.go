@@ -0,0 +1,313 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ConnectInvitesDataSource{}
+	_ datasource.DataSourceWithConfigure = &ConnectInvitesDataSource{}
+)
+
+func NewConnectInvitesDataSource() datasource.DataSource {
+	return &ConnectInvitesDataSource{}
+}
+
+// ConnectInvitesDataSource defines the data source implementation.
+type ConnectInvitesDataSource struct {
+	client *SlackClient
+}
+
+// ConnectInvitesDataSourceModel describes the data source data model.
+type ConnectInvitesDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	TeamId    types.String `tfsdk:"team_id"`
+	Direction types.String `tfsdk:"direction"`
+	InviteIds types.Set    `tfsdk:"invite_ids"`
+	Invites   types.List   `tfsdk:"invites"`
+}
+
+// ConnectInviteModel describes a single entry of invites.
+type ConnectInviteModel struct {
+	Id              types.String `tfsdk:"id"`
+	Direction       types.String `tfsdk:"direction"`
+	Status          types.String `tfsdk:"status"`
+	ChannelId       types.String `tfsdk:"channel_id"`
+	ChannelName     types.String `tfsdk:"channel_name"`
+	InvitingTeamId  types.String `tfsdk:"inviting_team_id"`
+	InvitingUserId  types.String `tfsdk:"inviting_user_id"`
+	RecipientEmail  types.String `tfsdk:"recipient_email"`
+	RecipientUserId types.String `tfsdk:"recipient_user_id"`
+	DateCreated     types.Int64  `tfsdk:"date_created"`
+	DateInvalid     types.Int64  `tfsdk:"date_invalid"`
+}
+
+var connectInviteAttrTypes = map[string]attr.Type{
+	"id":                types.StringType,
+	"direction":         types.StringType,
+	"status":            types.StringType,
+	"channel_id":        types.StringType,
+	"channel_name":      types.StringType,
+	"inviting_team_id":  types.StringType,
+	"inviting_user_id":  types.StringType,
+	"recipient_email":   types.StringType,
+	"recipient_user_id": types.StringType,
+	"date_created":      types.Int64Type,
+	"date_invalid":      types.Int64Type,
+}
+
+// connectInvite is an invitation to a Slack Connect channel as listed by
+// conversations.listConnectInvites.
+type connectInvite struct {
+	Direction string `json:"direction"`
+	Status    string `json:"status"`
+	Invite    struct {
+		ID           string `json:"id"`
+		DateCreated  int64  `json:"date_created"`
+		DateInvalid  int64  `json:"date_invalid"`
+		InvitingTeam struct {
+			ID string `json:"id"`
+		} `json:"inviting_team"`
+		InvitingUser struct {
+			ID string `json:"id"`
+		} `json:"inviting_user"`
+		RecipientEmail  string `json:"recipient_email"`
+		RecipientUserID string `json:"recipient_user_id"`
+	} `json:"invite"`
+	Channel struct {
+		ID   string `json:"id"`
+		Name string `json:"name"`
+	} `json:"channel"`
+}
+
+// connectInvitesListResponse is the response of
+// conversations.listConnectInvites.
+type connectInvitesListResponse struct {
+	slack.SlackResponse
+	Invites          []connectInvite `json:"invites"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+func (d *ConnectInvitesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_connect_invites"
+}
+
+func (d *ConnectInvitesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the invitations to Slack Connect channels a workspace sent or received that have not been accepted by every party yet,
+such as to find stale invites to revoke.
+### Required Permissions
+- ` + "`conversations.connect:manage`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the invites were read from.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to list invites of. Required with org-level tokens.",
+				Optional:            true,
+			},
+			"direction": schema.StringAttribute{
+				MarkdownDescription: "Only list invites sent by the workspace, `outgoing`, or sent to it, `incoming`.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf("incoming", "outgoing"),
+				},
+			},
+			"invite_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of the IDs of the pending invites.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"invites": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each pending invite.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The invite's ID.",
+							Computed:            true,
+						},
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "`outgoing` for invites sent by the workspace, `incoming` for those sent to it.",
+							Computed:            true,
+						},
+						"status": schema.StringAttribute{
+							MarkdownDescription: "The status of the invite, such as `approved` when it was accepted but still awaits approval by an admin.",
+							Computed:            true,
+						},
+						"channel_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the channel the invite is to.",
+							Computed:            true,
+						},
+						"channel_name": schema.StringAttribute{
+							MarkdownDescription: "The name of the channel the invite is to.",
+							Computed:            true,
+						},
+						"inviting_team_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the workspace that sent the invite.",
+							Computed:            true,
+						},
+						"inviting_user_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user who sent the invite.",
+							Computed:            true,
+						},
+						"recipient_email": schema.StringAttribute{
+							MarkdownDescription: "The email address the invite was sent to, if any.",
+							Computed:            true,
+						},
+						"recipient_user_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the user the invite was sent to, if any.",
+							Computed:            true,
+						},
+						"date_created": schema.Int64Attribute{
+							MarkdownDescription: "Unix timestamp of when the invite was sent.",
+							Computed:            true,
+						},
+						"date_invalid": schema.Int64Attribute{
+							MarkdownDescription: "Unix timestamp of when the invite expires.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ConnectInvitesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ConnectInvitesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ConnectInvitesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	connectInvites, err := listConnectInvites(ctx, d.client, data.TeamId.ValueString())
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list Slack Connect invites, got error: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+	inviteIds := []string{}
+	invites := []ConnectInviteModel{}
+
+	for _, connectInvite := range connectInvites {
+		if !data.Direction.IsNull() && connectInvite.Direction != data.Direction.ValueString() {
+			continue
+		}
+
+		inviteIds = append(inviteIds, connectInvite.Invite.ID)
+		invites = append(invites, ConnectInviteModel{
+			Id:              types.StringValue(connectInvite.Invite.ID),
+			Direction:       types.StringValue(connectInvite.Direction),
+			Status:          types.StringValue(connectInvite.Status),
+			ChannelId:       types.StringValue(connectInvite.Channel.ID),
+			ChannelName:     types.StringValue(connectInvite.Channel.Name),
+			InvitingTeamId:  types.StringValue(connectInvite.Invite.InvitingTeam.ID),
+			InvitingUserId:  types.StringValue(connectInvite.Invite.InvitingUser.ID),
+			RecipientEmail:  d.client.emailValue(connectInvite.Invite.RecipientEmail),
+			RecipientUserId: types.StringValue(connectInvite.Invite.RecipientUserID),
+			DateCreated:     types.Int64Value(connectInvite.Invite.DateCreated),
+			DateInvalid:     types.Int64Value(connectInvite.Invite.DateInvalid),
+		})
+	}
+
+	// Set data from API response.
+	id := data.TeamId.ValueString()
+	if id == "" {
+		id = d.client.teamId
+	}
+	data.Id = types.StringValue(id)
+
+	data.InviteIds, diags = types.SetValueFrom(ctx, types.StringType, inviteIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.Invites, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: connectInviteAttrTypes}, invites)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listConnectInvites pages through conversations.listConnectInvites for the
+// workspace of the token or, with org-level tokens, the given workspace.
+func listConnectInvites(ctx context.Context, client *SlackClient, teamId string) ([]connectInvite, error) {
+	var connectInvites []connectInvite
+	cursor := ""
+
+	for {
+		values := url.Values{
+			"cursor": {cursor},
+			"count":  {"200"},
+		}
+		if teamId != "" {
+			values.Set("team_id", teamId)
+		}
+
+		var response connectInvitesListResponse
+
+		err := client.retry(ctx, "conversations.listConnectInvites", func() error {
+			return client.apiCall(ctx, "conversations.listConnectInvites", values, &response)
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		connectInvites = append(connectInvites, response.Invites...)
+
+		cursor = response.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return connectInvites, nil
+		}
+	}
+}
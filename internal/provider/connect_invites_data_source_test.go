@@ -0,0 +1,45 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConnectInvitesDataSource(t *testing.T) {
+	inviteId := testAccFixture(t, testEnvConnectInviteId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_connect_invites" "outgoing" {
+  direction = "outgoing"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.slack_connect_invites.outgoing", "invite_ids.*", inviteId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_connect_invites.outgoing", "invites.*", map[string]string{
+						"id":        inviteId,
+						"direction": "outgoing",
+					}),
+					resource.TestCheckResourceAttrSet("data.slack_connect_invites.outgoing", "invites.0.date_created"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "slack_connect_invites" "invalid" {
+  direction = "sideways"
+}
+`,
+				ExpectError: regexp.MustCompile(`value must be one of`),
+			},
+		},
+	})
+}
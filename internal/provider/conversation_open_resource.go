@@ -0,0 +1,180 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &ConversationOpenResource{}
+
+func NewConversationOpenResource() resource.Resource {
+	return &ConversationOpenResource{}
+}
+
+// ConversationOpenResource defines the resource implementation.
+type ConversationOpenResource struct {
+	client *SlackClient
+}
+
+// ConversationOpenResourceModel describes the resource data model.
+type ConversationOpenResourceModel struct {
+	Id      types.String `tfsdk:"id"`
+	UserIds types.Set    `tfsdk:"user_ids"`
+}
+
+func (r *ConversationOpenResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_conversation_open"
+}
+
+func (r *ConversationOpenResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Opens a direct message with a user, or a multi-person direct message with several, and records its ID so messages can be sent to it.
+Slack returns the existing conversation if one is already open with the same users. The conversation is closed on destroy.
+### Required Permissions
+` + "- `im:write`" + `
+` + "- `mpim:write` (Only for multi-person direct messages)" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the conversation.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the users to open the conversation with, besides the provider's own user. " +
+					"One user opens a direct message, and up to 8 a multi-person direct message.",
+				Required:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Set{
+					setvalidator.SizeBetween(1, 8),
+				},
+			},
+		},
+	}
+}
+
+func (r *ConversationOpenResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *ConversationOpenResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ConversationOpenResourceModel
+	var userIds []string
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channel *slack.Channel
+	err := client.retry(ctx, "conversations.open", func() error {
+		var err error
+		channel, _, _, err = client.OpenConversationContext(ctx, &slack.OpenConversationParameters{Users: userIds})
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to open conversation, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(channel.ID)
+
+	tflog.Trace(ctx, "Opened a slack conversation", map[string]any{"channel_id": channel.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *ConversationOpenResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// Direct messages cannot be deleted, and opening one with the same users
+	// again returns the same ID, so there is nothing to refresh.
+}
+
+func (r *ConversationOpenResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// user_ids requires replacement, so there is nothing to update.
+	var plan ConversationOpenResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *ConversationOpenResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ConversationOpenResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Closing a conversation that is already closed is not an error.
+	err := client.retry(ctx, "conversations.close", func() error {
+		_, _, err := client.CloseConversationContext(ctx, data.Id.ValueString())
+		return err
+	})
+
+	if err != nil {
+		if err.Error() == "channel_not_found" {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to close conversation, got error: %s", err))
+		return
+	}
+}
@@ -0,0 +1,39 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccConversationOpenResource(t *testing.T) {
+	userId := testAccFixture(t, testEnvUserId)
+	memberId := testAccFixture(t, testEnvMembersChannelMember)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_conversation_open" "dm" {
+  user_ids = ["` + userId + `"]
+}
+
+resource "slack_conversation_open" "mpim" {
+  user_ids = ["` + userId + `", "` + memberId + `"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestMatchResourceAttr("slack_conversation_open.dm", "id", regexp.MustCompile(`^D`)),
+					resource.TestCheckResourceAttrSet("slack_conversation_open.mpim", "id"),
+					resource.TestCheckResourceAttr("slack_conversation_open.mpim", "user_ids.#", "2"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,253 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &EmojiAliasResource{}
+var _ resource.ResourceWithImportState = &EmojiAliasResource{}
+
+func NewEmojiAliasResource() resource.Resource {
+	return &EmojiAliasResource{}
+}
+
+// EmojiAliasResource defines the resource implementation.
+type EmojiAliasResource struct {
+	client *SlackClient
+}
+
+// EmojiAliasResourceModel describes the resource data model.
+type EmojiAliasResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	AliasFor types.String `tfsdk:"alias_for"`
+}
+
+// emojiNameRegexp matches the names Slack allows for custom emoji, without
+// the surrounding colons.
+var emojiNameRegexp = regexp.MustCompile(`^[a-z0-9_+'-]+$`)
+
+// emojiAliasPrefix prefixes the target of an alias in emoji.list.
+const emojiAliasPrefix = "alias:"
+
+func (r *EmojiAliasResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_emoji_alias"
+}
+
+func (r *EmojiAliasResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Manages an alias for a standard or custom emoji, so ` + "`:approve:`" + ` can stand for ` + "`:white_check_mark:`" + `.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+` + "- `admin.teams:write`" + `
+` + "- `emoji:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Name of the alias.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the alias, without colons. Changing it renames the alias.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emojiNameRegexp, "must only contain lowercase letters, numbers, underscores, dashes, apostrophes and plus signs"),
+				},
+			},
+			"alias_for": schema.StringAttribute{
+				MarkdownDescription: "Name of the emoji the alias stands for, without colons.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(emojiNameRegexp, "must only contain lowercase letters, numbers, underscores, dashes, apostrophes and plus signs"),
+				},
+			},
+		},
+	}
+}
+
+func (r *EmojiAliasResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_emoji_alias")...)
+}
+
+func (r *EmojiAliasResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data EmojiAliasResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "admin.emoji.addAlias", func() error {
+		return client.apiCall(ctx, "admin.emoji.addAlias", url.Values{
+			"name":      {data.Name.ValueString()},
+			"alias_for": {data.AliasFor.ValueString()},
+		}, &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add emoji alias: %s, got error: %s", data.Name.ValueString(), err))
+		return
+	}
+
+	data.Id = data.Name
+
+	tflog.Trace(ctx, "Added a slack emoji alias", map[string]any{"emoji_name": data.Name.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmojiAliasResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data EmojiAliasResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var emoji map[string]string
+
+	err := client.retry(ctx, "emoji.list", func() (err error) {
+		emoji, err = client.GetEmojiContext(ctx)
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list emoji, got error: %s", err))
+		return
+	}
+
+	// The alias was removed, or replaced by a custom emoji of the same name,
+	// outside of Terraform.
+	target, ok := emoji[data.Id.ValueString()]
+	if !ok || !strings.HasPrefix(target, emojiAliasPrefix) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	data.Name = data.Id
+	data.AliasFor = types.StringValue(strings.TrimPrefix(target, emojiAliasPrefix))
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *EmojiAliasResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan, state EmojiAliasResourceModel
+	client := r.client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// alias_for requires replacement, so only the name changes in place.
+	err := client.retry(ctx, "admin.emoji.rename", func() error {
+		return client.apiCall(ctx, "admin.emoji.rename", url.Values{
+			"name":     {state.Name.ValueString()},
+			"new_name": {plan.Name.ValueString()},
+		}, &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rename emoji alias: %s, got error: %s", state.Name.ValueString(), err))
+		return
+	}
+
+	plan.Id = plan.Name
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *EmojiAliasResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data EmojiAliasResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "admin.emoji.remove", func() error {
+		return client.apiCall(ctx, "admin.emoji.remove", url.Values{
+			"name": {data.Id.ValueString()},
+		}, &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		if err.Error() == "emoji_not_found" {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove emoji alias, got error: %s", err))
+		return
+	}
+}
+
+func (r *EmojiAliasResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,98 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"regexp"
+	"strings"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("slack_emoji_alias", &resource.Sweeper{
+		Name: "slack_emoji_alias",
+		F:    sweepEmojiAliases,
+	})
+}
+
+// testSweepEmojiAliasName matches the aliases created by TestAccEmojiAliasResource.
+var testSweepEmojiAliasName = regexp.MustCompile(`^test-emoji-[a-z]{6}(-renamed)?$`)
+
+func sweepEmojiAliases(_ string) error {
+	ctx := context.Background()
+	client := sweeperClient()
+
+	emoji, err := client.GetEmojiContext(ctx)
+	if err != nil {
+		return err
+	}
+
+	for name, target := range emoji {
+		if !strings.HasPrefix(target, emojiAliasPrefix) || !testSweepEmojiAliasName.MatchString(name) {
+			continue
+		}
+
+		err := client.retry(ctx, "admin.emoji.remove", func() error {
+			return client.apiCall(ctx, "admin.emoji.remove", url.Values{"name": {name}}, &slack.SlackResponse{})
+		})
+		if err != nil {
+			return fmt.Errorf("unable to remove emoji alias %s: %s", name, err)
+		}
+	}
+
+	return nil
+}
+
+func TestAccEmojiAliasResource(t *testing.T) {
+	name := "test-emoji-" + testAccNameSuffix(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccEmojiAliasResourceConfig(name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_emoji_alias.test", "id", name),
+					resource.TestCheckResourceAttr("slack_emoji_alias.test", "alias_for", "white_check_mark"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_emoji_alias.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccEmojiAliasResourceConfig(name+"-renamed"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_emoji_alias.test", "id", name+"-renamed"),
+					resource.TestCheckResourceAttr("slack_emoji_alias.test", "name", name+"-renamed"),
+				),
+			},
+			{
+				Config:      providerConfig + testAccEmojiAliasResourceConfig(":Approve:"),
+				ExpectError: regexp.MustCompile(`must only contain lowercase letters`),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccEmojiAliasResourceConfig(name string) string {
+	return `
+resource "slack_emoji_alias" "test" {
+  name      = "` + name + `"
+  alias_for = "white_check_mark"
+}
+`
+}
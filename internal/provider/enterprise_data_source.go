@@ -0,0 +1,258 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &EnterpriseDataSource{}
+	_ datasource.DataSourceWithConfigure = &EnterpriseDataSource{}
+)
+
+func NewEnterpriseDataSource() datasource.DataSource {
+	return &EnterpriseDataSource{}
+}
+
+// EnterpriseDataSource defines the data source implementation.
+type EnterpriseDataSource struct {
+	client *SlackClient
+}
+
+// EnterpriseDataSourceModel describes the data source data model.
+type EnterpriseDataSourceModel struct {
+	Id           types.String `tfsdk:"id"`
+	IsEnterprise types.Bool   `tfsdk:"is_enterprise"`
+	EnterpriseId types.String `tfsdk:"enterprise_id"`
+	Name         types.String `tfsdk:"name"`
+	Domain       types.String `tfsdk:"domain"`
+	TeamIds      types.Set    `tfsdk:"team_ids"`
+	Teams        types.List   `tfsdk:"teams"`
+}
+
+// EnterpriseTeamModel describes a single entry of teams.
+type EnterpriseTeamModel struct {
+	Id     types.String `tfsdk:"id"`
+	Name   types.String `tfsdk:"name"`
+	Domain types.String `tfsdk:"domain"`
+}
+
+var enterpriseTeamAttrTypes = map[string]attr.Type{
+	"id":     types.StringType,
+	"name":   types.StringType,
+	"domain": types.StringType,
+}
+
+// teamInfoResponse is the response of team.info, including the enterprise
+// fields slack.TeamInfo leaves out.
+type teamInfoResponse struct {
+	slack.SlackResponse
+	Team struct {
+		ID               string `json:"id"`
+		EnterpriseID     string `json:"enterprise_id"`
+		EnterpriseName   string `json:"enterprise_name"`
+		EnterpriseDomain string `json:"enterprise_domain"`
+	} `json:"team"`
+}
+
+type authTeamsListResponse struct {
+	slack.SlackResponse
+	Teams []slack.Team `json:"teams"`
+}
+
+func (d *EnterpriseDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_enterprise"
+}
+
+func (d *EnterpriseDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the Enterprise Grid organization the provider's workspace belongs to, and the workspaces visible to the token.
+For workspaces outside of an organization, ` + "`is_enterprise`" + ` is false and only the workspace itself is listed.
+### Required Permissions
+- ` + "`team:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization, or of the workspace outside of one.",
+				Computed:            true,
+			},
+			"is_enterprise": schema.BoolAttribute{
+				MarkdownDescription: "Whether the workspace belongs to an Enterprise Grid organization.",
+				Computed:            true,
+			},
+			"enterprise_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the organization. Null outside of one.",
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the organization. Null outside of one.",
+				Computed:            true,
+			},
+			"domain": schema.StringAttribute{
+				MarkdownDescription: "The domain of the organization. Null outside of one.",
+				Computed:            true,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of the IDs of the workspaces visible to the token.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"teams": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each workspace visible to the token.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The workspace's ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The workspace's name.",
+							Computed:            true,
+						},
+						"domain": schema.StringAttribute{
+							MarkdownDescription: "The workspace's domain.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *EnterpriseDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *EnterpriseDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data EnterpriseDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var info teamInfoResponse
+
+	err := d.client.retry(ctx, "team.info", func() error {
+		return d.client.apiCall(ctx, "team.info", url.Values{}, &info)
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read team info, got error: %s", err))
+		return
+	}
+
+	teams, err := listAuthTeams(ctx, d.client)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list teams, got error: %s", err))
+		return
+	}
+
+	// Set data from API response.
+	if info.Team.EnterpriseID != "" {
+		data.Id = types.StringValue(info.Team.EnterpriseID)
+		data.IsEnterprise = types.BoolValue(true)
+		data.EnterpriseId = types.StringValue(info.Team.EnterpriseID)
+		data.Name = types.StringValue(info.Team.EnterpriseName)
+		data.Domain = types.StringValue(info.Team.EnterpriseDomain)
+	} else {
+		data.Id = types.StringValue(info.Team.ID)
+		data.IsEnterprise = types.BoolValue(false)
+		data.EnterpriseId = types.StringNull()
+		data.Name = types.StringNull()
+		data.Domain = types.StringNull()
+	}
+
+	teamIds := make([]string, 0, len(teams))
+	teamModels := make([]EnterpriseTeamModel, 0, len(teams))
+	for _, team := range teams {
+		teamIds = append(teamIds, team.ID)
+		teamModels = append(teamModels, EnterpriseTeamModel{
+			Id:     types.StringValue(team.ID),
+			Name:   types.StringValue(team.Name),
+			Domain: types.StringValue(team.Domain),
+		})
+	}
+
+	var diags diag.Diagnostics
+
+	data.TeamIds, diags = types.SetValueFrom(ctx, types.StringType, teamIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.Teams, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: enterpriseTeamAttrTypes}, teamModels)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+// listAuthTeams lists the workspaces the token can access with
+// auth.teams.list. The Slack API client's ListTeams does not page through
+// them.
+func listAuthTeams(ctx context.Context, client *SlackClient) ([]slack.Team, error) {
+	var teams []slack.Team
+	cursor := ""
+
+	for {
+		values := url.Values{
+			"cursor": {cursor},
+			"limit":  {"100"},
+		}
+
+		var response authTeamsListResponse
+
+		err := client.retry(ctx, "auth.teams.list", func() error {
+			return client.apiCall(ctx, "auth.teams.list", values, &response)
+		})
+
+		if err != nil {
+			return nil, err
+		}
+
+		teams = append(teams, response.Teams...)
+
+		cursor = response.ResponseMetadata.Cursor
+		if cursor == "" {
+			return teams, nil
+		}
+	}
+}
@@ -0,0 +1,33 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccEnterpriseDataSource(t *testing.T) {
+	enterpriseId := testAccFixture(t, testEnvEnterpriseId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_enterprise" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_enterprise.test", "id", enterpriseId),
+					resource.TestCheckResourceAttr("data.slack_enterprise.test", "is_enterprise", "true"),
+					resource.TestCheckResourceAttr("data.slack_enterprise.test", "enterprise_id", enterpriseId),
+					resource.TestCheckResourceAttrSet("data.slack_enterprise.test", "name"),
+					resource.TestCheckResourceAttrSet("data.slack_enterprise.test", "teams.0.id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,317 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+const (
+	functionPermissionEveryone         = "everyone"
+	functionPermissionAppCollaborators = "app_collaborators"
+	functionPermissionNamedEntities    = "named_entities"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &FunctionDistributionResource{}
+var _ resource.ResourceWithImportState = &FunctionDistributionResource{}
+var _ resource.ResourceWithValidateConfig = &FunctionDistributionResource{}
+
+func NewFunctionDistributionResource() resource.Resource {
+	return &FunctionDistributionResource{}
+}
+
+// FunctionDistributionResource defines the resource implementation.
+type FunctionDistributionResource struct {
+	client *SlackClient
+}
+
+// FunctionDistributionResourceModel describes the resource data model.
+type FunctionDistributionResourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	FunctionId     types.String `tfsdk:"function_id"`
+	PermissionType types.String `tfsdk:"permission_type"`
+	UserIds        types.Set    `tfsdk:"user_ids"`
+}
+
+// functionDistributionResponse is the response of
+// functions.distributions.permissions.list.
+type functionDistributionResponse struct {
+	slack.SlackResponse
+	PermissionType string `json:"permission_type"`
+	Users          []struct {
+		ID string `json:"id"`
+	} `json:"users"`
+}
+
+func (r *FunctionDistributionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_function_distribution"
+}
+
+func (r *FunctionDistributionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Controls who can use a custom workflow function published by an app.
+Destroying the resource restricts the function to the app's collaborators again.
+### Required Permissions
+- A token of the app that publishes the function.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the function.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the function to distribute.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"permission_type": schema.StringAttribute{
+				MarkdownDescription: "Who can use the function. One of `everyone`, `app_collaborators` or `named_entities`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(
+						functionPermissionEveryone,
+						functionPermissionAppCollaborators,
+						functionPermissionNamedEntities,
+					),
+				},
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the users who can use the function. Required when `permission_type` is `named_entities`, and not allowed otherwise.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func (r *FunctionDistributionResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data FunctionDistributionResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.PermissionType.IsUnknown() || data.UserIds.IsUnknown() {
+		return
+	}
+
+	namedEntities := data.PermissionType.ValueString() == functionPermissionNamedEntities
+
+	if namedEntities && data.UserIds.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_ids"),
+			"Missing Attribute Configuration",
+			"user_ids must be set when permission_type is named_entities.",
+		)
+	}
+	if !namedEntities && !data.UserIds.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("user_ids"),
+			"Invalid Attribute Combination",
+			"user_ids can only be set when permission_type is named_entities.",
+		)
+	}
+}
+
+func (r *FunctionDistributionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// setFunctionDistribution replaces who can use a function.
+func setFunctionDistribution(ctx context.Context, client *SlackClient, functionId string, permissionType string, userIds []string) error {
+	values := url.Values{
+		"function_id":     {functionId},
+		"permission_type": {permissionType},
+	}
+	if len(userIds) > 0 {
+		values.Set("user_ids", strings.Join(userIds, ","))
+	}
+
+	return client.retry(ctx, "functions.distributions.permissions.set", func() error {
+		return client.apiCall(ctx, "functions.distributions.permissions.set", values, &slack.SlackResponse{})
+	})
+}
+
+func (r *FunctionDistributionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data FunctionDistributionResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setFunctionDistribution(ctx, client, data.FunctionId.ValueString(), data.PermissionType.ValueString(), userIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set distribution of function: %s, got error: %s", data.FunctionId.ValueString(), err))
+		return
+	}
+
+	data.Id = data.FunctionId
+
+	tflog.Trace(ctx, "Set a slack function distribution", map[string]any{"function_id": data.FunctionId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionDistributionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data FunctionDistributionResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response functionDistributionResponse
+
+	err := client.retry(ctx, "functions.distributions.permissions.list", func() error {
+		return client.apiCall(ctx, "functions.distributions.permissions.list", url.Values{
+			"function_id": {data.Id.ValueString()},
+		}, &response)
+	})
+
+	if err != nil {
+		if err.Error() == "function_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read function distribution, got error: %s", err))
+		return
+	}
+
+	data.FunctionId = data.Id
+	data.PermissionType = types.StringValue(response.PermissionType)
+
+	if response.PermissionType == functionPermissionNamedEntities {
+		userIds := make([]string, 0, len(response.Users))
+		for _, user := range response.Users {
+			userIds = append(userIds, user.ID)
+		}
+
+		userIdsValue, diags := types.SetValueFrom(ctx, types.StringType, userIds)
+		resp.Diagnostics.Append(diags...)
+		data.UserIds = userIdsValue
+	} else {
+		data.UserIds = types.SetNull(types.StringType)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *FunctionDistributionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan FunctionDistributionResourceModel
+	client := r.client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+	resp.Diagnostics.Append(plan.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setFunctionDistribution(ctx, client, plan.FunctionId.ValueString(), plan.PermissionType.ValueString(), userIds)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update function distribution, got error: %s", err))
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *FunctionDistributionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data FunctionDistributionResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := setFunctionDistribution(ctx, client, data.Id.ValueString(), functionPermissionAppCollaborators, nil)
+
+	if err != nil {
+		if err.Error() == "function_not_found" {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset function distribution, got error: %s", err))
+		return
+	}
+}
+
+func (r *FunctionDistributionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
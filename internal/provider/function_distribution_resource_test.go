@@ -0,0 +1,57 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccFunctionDistributionResource(t *testing.T) {
+	functionId := testAccFixture(t, testEnvFunctionId)
+	userId := testAccFixture(t, testEnvUserId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_function_distribution" "test" {
+  function_id     = "` + functionId + `"
+  permission_type = "everyone"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_function_distribution.test", "id", functionId),
+					resource.TestCheckResourceAttr("slack_function_distribution.test", "permission_type", "everyone"),
+					resource.TestCheckNoResourceAttr("slack_function_distribution.test", "user_ids"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_function_distribution.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_function_distribution" "test" {
+  function_id     = "` + functionId + `"
+  permission_type = "named_entities"
+  user_ids        = ["` + userId + `"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_function_distribution.test", "permission_type", "named_entities"),
+					resource.TestCheckResourceAttr("slack_function_distribution.test", "user_ids.#", "1"),
+					resource.TestCheckTypeSetElemAttr("slack_function_distribution.test", "user_ids.*", userId),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,26 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"strings"
+)
+
+// govSlackAPIURL is the base URL of the Web API of GovSlack, the separate
+// Slack deployment for US public sector organizations. GovSlack tokens are
+// rejected by the commercial API and vice versa.
+const govSlackAPIURL = "https://slack-gov.com/api/"
+
+// resolveAPIURL returns the base URL of the Slack Web API to use. An
+// explicitly configured apiURL, such as that of a proxy, takes precedence
+// over govSlack. An empty result means the default commercial API.
+func resolveAPIURL(apiURL string, govSlack bool) string {
+	if apiURL == "" && govSlack {
+		apiURL = govSlackAPIURL
+	}
+	if apiURL != "" && !strings.HasSuffix(apiURL, "/") {
+		apiURL += "/"
+	}
+	return apiURL
+}
@@ -0,0 +1,54 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestResolveAPIURL(t *testing.T) {
+	for _, test := range []struct {
+		apiURL   string
+		govSlack bool
+		expected string
+	}{
+		{apiURL: "", govSlack: false, expected: ""},
+		{apiURL: "", govSlack: true, expected: govSlackAPIURL},
+		{apiURL: "https://proxy.example.com/api", govSlack: false, expected: "https://proxy.example.com/api/"},
+		{apiURL: "https://proxy.example.com/api/", govSlack: true, expected: "https://proxy.example.com/api/"},
+	} {
+		if apiURL := resolveAPIURL(test.apiURL, test.govSlack); apiURL != test.expected {
+			t.Errorf("expected %q with govslack %t to resolve to %q, got: %q", test.apiURL, test.govSlack, test.expected, apiURL)
+		}
+	}
+}
+
+// TestAccProviderGovSlack runs against a GovSlack workspace when SLACK_TOKEN
+// is one of its tokens. The rest of the acceptance tests run against GovSlack
+// too when SLACK_GOVSLACK is set as well.
+func TestAccProviderGovSlack(t *testing.T) {
+	testAccFixture(t, testEnvGovSlack)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "slack" {
+  govslack = true
+}
+
+data "slack_token_scopes" "test" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_token_scopes.test", "team_id"),
+					resource.TestCheckResourceAttrSet("data.slack_token_scopes.test", "user_id"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,79 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/identityschema"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// SlackIdentityModel describes the identity shared by managed Slack objects:
+// the object's Slack ID and the workspace it belongs to.
+type SlackIdentityModel struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+}
+
+func slackIdentitySchema(object string) identityschema.Schema {
+	return identityschema.Schema{
+		Attributes: map[string]identityschema.Attribute{
+			"id": identityschema.StringAttribute{
+				Description:       fmt.Sprintf("Slack ID of the %s.", object),
+				RequiredForImport: true,
+			},
+			"team_id": identityschema.StringAttribute{
+				Description:       fmt.Sprintf("ID of the workspace the %s belongs to. Defaults to the provider's workspace.", object),
+				OptionalForImport: true,
+			},
+		},
+	}
+}
+
+// setIdentity records the identity of the object with the given ID in the
+// provider's workspace. Identity is nil when Terraform does not support
+// resource identity, in which case there is nothing to do.
+func (c *SlackClient) setIdentity(ctx context.Context, identity *tfsdk.ResourceIdentity, id string) diag.Diagnostics {
+	if identity == nil {
+		return nil
+	}
+
+	return identity.Set(ctx, SlackIdentityModel{
+		Id:     types.StringValue(id),
+		TeamId: types.StringValue(c.teamId),
+	})
+}
+
+// importStateWithIdentity imports by import ID or by identity. An identity
+// naming a different workspace than the provider's is rejected, as the object
+// could not be read with the configured token.
+func (c *SlackClient) importStateWithIdentity(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	if req.ID == "" && req.Identity != nil {
+		var identity SlackIdentityModel
+
+		resp.Diagnostics.Append(req.Identity.Get(ctx, &identity)...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		teamId := identity.TeamId.ValueString()
+		if teamId != "" && c.teamId != "" && teamId != c.teamId {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("team_id"),
+				"Unexpected Workspace",
+				fmt.Sprintf("The identity belongs to workspace %s, but the provider is configured for workspace %s.", teamId, c.teamId),
+			)
+			return
+		}
+	}
+
+	resource.ImportStatePassthroughWithIdentity(ctx, path.Root("id"), path.Root("id"), req, resp)
+}
@@ -0,0 +1,54 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// movesFromCommunityProvider reports whether req moves a resource of type
+// typeName out of the pablovarela/slack provider, which managed channels and
+// User Groups before this provider did. The registry hostname is ignored, so
+// mirrored providers match as well.
+func movesFromCommunityProvider(req resource.MoveStateRequest, typeName string) bool {
+	return req.SourceTypeName == typeName && strings.HasSuffix(req.SourceProviderAddress, "/pablovarela/slack")
+}
+
+// decodeSourceState decodes the raw state of the resource being moved into
+// state, ignoring attributes state has no field for. The raw state is used
+// rather than a source schema, so state of any version of the source
+// provider can be moved.
+func decodeSourceState(req resource.MoveStateRequest, state any) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if req.SourceRawState == nil {
+		diags.AddError("Unable to Move Resource State", "The source resource has no state to move.")
+		return diags
+	}
+
+	if err := json.Unmarshal(req.SourceRawState.JSON, state); err != nil {
+		diags.AddError(
+			"Unable to Move Resource State",
+			fmt.Sprintf("Unable to decode the state of %s, got error: %s", req.SourceTypeName, err),
+		)
+	}
+
+	return diags
+}
+
+// optionalString returns value as a String, or null when it is empty.
+// SDKv2 providers such as pablovarela/slack store unset strings as "", while
+// this provider does not manage attributes that are null.
+func optionalString(value string) types.String {
+	if value == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(value)
+}
@@ -0,0 +1,115 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// moveState moves the given raw state into r, returning the response of the
+// first state mover that handles it.
+func moveState(t *testing.T, r resource.ResourceWithMoveState, providerAddress string, typeName string, rawState string) *resource.MoveStateResponse {
+	t.Helper()
+	ctx := context.Background()
+
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	req := resource.MoveStateRequest{
+		SourceProviderAddress: providerAddress,
+		SourceTypeName:        typeName,
+		SourceRawState:        &tfprotov6.RawState{JSON: []byte(rawState)},
+	}
+
+	for _, mover := range r.MoveState(ctx) {
+		resp := &resource.MoveStateResponse{
+			TargetState: tfsdk.State{
+				Schema: schemaResp.Schema,
+				Raw:    tftypes.NewValue(schemaResp.Schema.Type().TerraformType(ctx), nil),
+			},
+		}
+		mover.StateMover(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() || !resp.TargetState.Raw.IsNull() {
+			return resp
+		}
+	}
+	return nil
+}
+
+func TestChannelResourceMoveState(t *testing.T) {
+	ctx := context.Background()
+
+	resp := moveState(t, &ChannelResource{}, "registry.terraform.io/pablovarela/slack", "slack_conversation", `{
+		"id": "C0123456789",
+		"name": "general-chat",
+		"topic": "",
+		"purpose": "Anything goes",
+		"is_private": true,
+		"is_archived": false,
+		"permanent_members": ["U0123456789"],
+		"action_on_destroy": "archive"
+	}`)
+	if resp == nil {
+		t.Fatal("expected slack_conversation to be moved")
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data ChannelResourceModel
+	if diags := resp.TargetState.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if data.Id.ValueString() != "C0123456789" || data.Name.ValueString() != "general-chat" || !data.IsPrivate.ValueBool() {
+		t.Errorf("unexpected moved state: %+v", data)
+	}
+	if !data.Topic.IsNull() || data.Description.ValueString() != "Anything goes" {
+		t.Errorf("expected empty topic to be unmanaged and purpose to become the description, got: %+v", data)
+	}
+
+	if moveState(t, &ChannelResource{}, "registry.terraform.io/other/slack", "slack_conversation", `{}`) != nil {
+		t.Error("expected slack_conversation of another provider not to be moved")
+	}
+}
+
+func TestUserGroupResourceMoveState(t *testing.T) {
+	ctx := context.Background()
+
+	resp := moveState(t, &UserGroupResource{}, "registry.terraform.io/pablovarela/slack", "slack_usergroup", `{
+		"id": "S0123456789",
+		"name": "Platform Team",
+		"handle": "platform",
+		"description": "",
+		"channels": ["C0123456789"],
+		"users": ["U0123456789"]
+	}`)
+	if resp == nil {
+		t.Fatal("expected slack_usergroup to be moved")
+	}
+	if resp.Diagnostics.HasError() {
+		t.Fatalf("unexpected error: %v", resp.Diagnostics)
+	}
+
+	var data UserGroupResourceModel
+	if diags := resp.TargetState.Get(ctx, &data); diags.HasError() {
+		t.Fatalf("unexpected error: %v", diags)
+	}
+	if data.Id.ValueString() != "S0123456789" || data.Name.ValueString() != "Platform Team" || data.Handle.ValueString() != "platform" {
+		t.Errorf("unexpected moved state: %+v", data)
+	}
+	if !data.Description.IsNull() {
+		t.Errorf("expected empty description to be unmanaged, got: %s", data.Description)
+	}
+
+	if moveState(t, &UserGroupResource{}, "registry.terraform.io/pablovarela/slack", "slack_conversation", `{}`) != nil {
+		t.Error("expected slack_conversation not to be moved into slack_usergroup")
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &MrkdwnLinkFunction{}
+
+func NewMrkdwnLinkFunction() function.Function {
+	return &MrkdwnLinkFunction{}
+}
+
+// MrkdwnLinkFunction defines the function implementation.
+type MrkdwnLinkFunction struct{}
+
+// mrkdwnEscaper escapes the characters Slack reserves for its markup.
+var mrkdwnEscaper = strings.NewReplacer("&", "&amp;", "<", "&lt;", ">", "&gt;")
+
+func (f *MrkdwnLinkFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "mrkdwn_link"
+}
+
+func (f *MrkdwnLinkFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Builds a Slack mrkdwn link",
+		MarkdownDescription: "Builds a link in Slack's mrkdwn format, `<url|text>`, for use in channel topics and descriptions or messages. " +
+			"`&`, `<` and `>` are escaped in both the URL and the text, and `|` is percent-encoded in the URL, so neither can break out of the link. " +
+			"An empty text links the bare URL.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "url",
+				MarkdownDescription: "The URL to link to.",
+			},
+			function.StringParameter{
+				Name:                "text",
+				MarkdownDescription: "The text shown for the link.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *MrkdwnLinkFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var url, text string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &url, &text))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if url == "" {
+		resp.Error = function.NewArgumentFuncError(0, "The URL must not be empty.")
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, mrkdwnLink(url, text)))
+}
+
+// mrkdwnLink returns a mrkdwn link to url with the given text.
+func mrkdwnLink(url string, text string) string {
+	link := "<" + mrkdwnEscaper.Replace(strings.ReplaceAll(url, "|", "%7C"))
+	if text != "" {
+		link += "|" + mrkdwnEscaper.Replace(text)
+	}
+	return link + ">"
+}
@@ -0,0 +1,53 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccMrkdwnLinkFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::slack::mrkdwn_link("https://example.com/runbook?a=1&b=2", "Runbook <prod>")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("<https://example.com/runbook?a=1&amp;b=2|Runbook &lt;prod&gt;>")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::slack::mrkdwn_link("https://example.com/a|b", "")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("<https://example.com/a%7Cb>")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::slack::mrkdwn_link("", "Runbook")
+}
+`,
+				ExpectError: regexp.MustCompile(`URL must not be empty`),
+			},
+		},
+	})
+}
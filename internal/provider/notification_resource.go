@@ -0,0 +1,223 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"text/template"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &NotificationResource{}
+var _ resource.ResourceWithValidateConfig = &NotificationResource{}
+
+func NewNotificationResource() resource.Resource {
+	return &NotificationResource{}
+}
+
+// NotificationResource defines the resource implementation.
+type NotificationResource struct {
+	client *SlackClient
+}
+
+// NotificationResourceModel describes the resource data model.
+type NotificationResourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	Channel  types.String `tfsdk:"channel"`
+	Text     types.String `tfsdk:"text"`
+	Triggers types.Map    `tfsdk:"triggers"`
+	Message  types.String `tfsdk:"message"`
+}
+
+func (r *NotificationResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_notification"
+}
+
+func (r *NotificationResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Posts a message to a channel when it is created, and again whenever ` + "`triggers`" + ` change.
+Changing only the channel or the text does not post a message. Destroying the resource leaves posted messages in place.
+### Required Permissions
+` + "- `chat:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Timestamp of the last message posted, which identifies it within the channel.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"channel": schema.StringAttribute{
+				MarkdownDescription: "ID of the channel to post to.",
+				Required:            true,
+			},
+			"text": schema.StringAttribute{
+				MarkdownDescription: "The message to post. It is rendered as a Go template with `triggers` as its data, " +
+					"so `{{ .version }}` is replaced with the value of the `version` trigger.",
+				Required: true,
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that post the message again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+			"message": schema.StringAttribute{
+				MarkdownDescription: "The rendered text of the last message posted.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *NotificationResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data NotificationResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() || data.Text.IsUnknown() || data.Text.IsNull() {
+		return
+	}
+
+	if _, err := parseNotificationTemplate(data.Text.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("text"),
+			"Invalid Message Template",
+			fmt.Sprintf("Unable to parse text as a template, got error: %s", err),
+		)
+	}
+}
+
+func (r *NotificationResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *NotificationResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data NotificationResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	triggers := map[string]string{}
+	resp.Diagnostics.Append(data.Triggers.ElementsAs(ctx, &triggers, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	message, err := renderNotification(data.Text.ValueString(), triggers)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Invalid Message Template", fmt.Sprintf("Unable to render text, got error: %s", err))
+		return
+	}
+
+	var timestamp string
+
+	err = client.retry(ctx, "chat.postMessage", func() (err error) {
+		_, timestamp, err = client.PostMessageContext(
+			ctx, data.Channel.ValueString(), slack.MsgOptionText(message, false),
+		)
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to post message to channel: %s, got error: %s", data.Channel.ValueString(), err))
+		return
+	}
+
+	data.Id = types.StringValue(timestamp)
+	data.Message = types.StringValue(message)
+
+	tflog.Trace(ctx, "Posted a slack notification", map[string]any{"message_ts": timestamp})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *NotificationResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A posted message is not tracked after the fact, so there is nothing to
+	// refresh.
+}
+
+func (r *NotificationResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan NotificationResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only changes to triggers post a message, and those replace the
+	// resource, so updates just record the new configuration.
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *NotificationResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Posted messages are left in place.
+}
+
+func parseNotificationTemplate(text string) (*template.Template, error) {
+	return template.New("text").Option("missingkey=error").Parse(text)
+}
+
+// renderNotification renders a notification's text with its triggers.
+func renderNotification(text string, triggers map[string]string) (string, error) {
+	tmpl, err := parseNotificationTemplate(text)
+	if err != nil {
+		return "", err
+	}
+
+	var message strings.Builder
+	if err := tmpl.Execute(&message, triggers); err != nil {
+		return "", err
+	}
+	return message.String(), nil
+}
@@ -0,0 +1,94 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/compare"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+)
+
+func TestAccNotificationResource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvChannelId)
+
+	sameMessage := statecheck.CompareValue(compare.ValuesSame())
+	differentMessage := statecheck.CompareValue(compare.ValuesDiffer())
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create posts a message
+			{
+				Config: providerConfig + `
+resource "slack_notification" "test" {
+  channel  = "` + channelId + `"
+  text     = "Deployed {{ .version }}"
+  triggers = {
+    version = "1.0.0"
+  }
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("slack_notification.test", tfjsonpath.New("message"), knownvalue.StringExact("Deployed 1.0.0")),
+					sameMessage.AddStateValue("slack_notification.test", tfjsonpath.New("id")),
+					differentMessage.AddStateValue("slack_notification.test", tfjsonpath.New("id")),
+				},
+			},
+			// Changing the text alone does not post again
+			{
+				Config: providerConfig + `
+resource "slack_notification" "test" {
+  channel  = "` + channelId + `"
+  text     = "Released {{ .version }}"
+  triggers = {
+    version = "1.0.0"
+  }
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("slack_notification.test", tfjsonpath.New("message"), knownvalue.StringExact("Deployed 1.0.0")),
+					sameMessage.AddStateValue("slack_notification.test", tfjsonpath.New("id")),
+				},
+			},
+			// Changing the triggers posts again
+			{
+				Config: providerConfig + `
+resource "slack_notification" "test" {
+  channel  = "` + channelId + `"
+  text     = "Released {{ .version }}"
+  triggers = {
+    version = "1.1.0"
+  }
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("slack_notification.test", tfjsonpath.New("message"), knownvalue.StringExact("Released 1.1.0")),
+					differentMessage.AddStateValue("slack_notification.test", tfjsonpath.New("id")),
+				},
+			},
+		},
+	})
+}
+
+func TestRenderNotification(t *testing.T) {
+	message, err := renderNotification("Deployed {{ .version }} to {{ .env }}", map[string]string{
+		"version": "1.2.3",
+		"env":     "production",
+	})
+	if err != nil || message != "Deployed 1.2.3 to production" {
+		t.Fatalf("expected rendered message, got %q and error: %v", message, err)
+	}
+
+	if _, err := renderNotification("Deployed {{ .version }}", map[string]string{}); err == nil {
+		t.Fatalf("expected an error for a missing trigger")
+	}
+
+	if _, err := renderNotification("Deployed {{ .version", nil); err == nil {
+		t.Fatalf("expected an error for an invalid template")
+	}
+}
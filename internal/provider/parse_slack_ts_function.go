@@ -0,0 +1,73 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ParseSlackTsFunction{}
+
+func NewParseSlackTsFunction() function.Function {
+	return &ParseSlackTsFunction{}
+}
+
+// ParseSlackTsFunction defines the function implementation.
+type ParseSlackTsFunction struct{}
+
+// slackTsRegexp matches Slack timestamps, Unix seconds followed by a sequence
+// number that keeps messages of the same second apart.
+var slackTsRegexp = regexp.MustCompile(`^[0-9]+(\.[0-9]+)?$`)
+
+func (f *ParseSlackTsFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "parse_slack_ts"
+}
+
+func (f *ParseSlackTsFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Converts a Slack timestamp into an RFC 3339 timestamp",
+		MarkdownDescription: "Converts a Slack `ts` value, such as the `id` of `slack_notification`, into an RFC 3339 timestamp in UTC, " +
+			"for use with Terraform's time functions or in human-readable outputs. The part after the dot only keeps messages " +
+			"of the same second apart, so it is dropped.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "ts",
+				MarkdownDescription: "The Slack timestamp, such as `1712345678.000200`.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ParseSlackTsFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var ts string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &ts))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if !slackTsRegexp.MatchString(ts) {
+		resp.Error = function.NewArgumentFuncError(0, "Expected a Slack timestamp such as 1712345678.000200, got: "+strconv.Quote(ts))
+		return
+	}
+
+	seconds, _, _ := strings.Cut(ts, ".")
+
+	unix, err := strconv.ParseInt(seconds, 10, 64)
+	if err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Unable to parse Slack timestamp: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, time.Unix(unix, 0).UTC().Format(time.RFC3339)))
+}
@@ -0,0 +1,53 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccParseSlackTsFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::slack::parse_slack_ts("1712345678.000200")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("2024-04-05T19:34:38Z")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::slack::parse_slack_ts("1712345678")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("2024-04-05T19:34:38Z")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::slack::parse_slack_ts("yesterday")
+}
+`,
+				ExpectError: regexp.MustCompile(`Expected a Slack timestamp`),
+			},
+		},
+	})
+}
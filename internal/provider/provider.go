@@ -5,20 +5,31 @@ package provider
 
 import (
 	"context"
+	"net/http"
 	"os"
+	"strconv"
+	"time"
 
 	"github.com/slack-go/slack"
 
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/provider"
 	"github.com/hashicorp/terraform-plugin-framework/provider/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 )
 
 // Ensure SlackProvider satisfies various provider interfaces.
 var _ provider.Provider = &SlackProvider{}
+var _ provider.ProviderWithListResources = &SlackProvider{}
+var _ provider.ProviderWithEphemeralResources = &SlackProvider{}
 
 // SlackProvider defines the provider implementation.
 type SlackProvider struct {
@@ -26,11 +37,30 @@ type SlackProvider struct {
 	// provider is built and ran locally, and "test" when running acceptance
 	// testing.
 	version string
+
+	// transport overrides the HTTP transport used to reach the Slack API.
+	// Acceptance tests use it to record and replay API traffic.
+	transport http.RoundTripper
 }
 
 // SlackProviderModel describes the provider data model.
 type SlackProviderModel struct {
-	Token types.String `tfsdk:"token"`
+	Token                   types.String `tfsdk:"token"`
+	APIURL                  types.String `tfsdk:"api_url"`
+	GovSlack                types.Bool   `tfsdk:"govslack"`
+	ExpectedTeamId          types.String `tfsdk:"expected_team_id"`
+	AdminChannelSearch      types.Bool   `tfsdk:"admin_channel_search"`
+	CheckUserGroupConflicts types.Bool   `tfsdk:"check_usergroup_conflicts"`
+	InsecureSkipVerify      types.Bool   `tfsdk:"insecure_skip_verify"`
+	CACertPEM               types.String `tfsdk:"ca_cert_pem"`
+	UserAgentSuffix         types.String `tfsdk:"user_agent_suffix"`
+	Headers                 types.Map    `tfsdk:"headers"`
+	CacheTTL                types.String `tfsdk:"cache_ttl"`
+	CacheDir                types.String `tfsdk:"cache_dir"`
+	RedactEmails            types.Bool   `tfsdk:"redact_emails"`
+	ScopeReportFile         types.String `tfsdk:"scope_report_file"`
+	RetryOn                 types.List   `tfsdk:"retry_on"`
+	RetryMaxElapsed         types.String `tfsdk:"retry_max_elapsed"`
 }
 
 func (p *SlackProvider) Metadata(ctx context.Context, req provider.MetadataRequest, resp *provider.MetadataResponse) {
@@ -45,12 +75,100 @@ A provider to interact with and manage Slack resources.
 
 A slack bot and its OAuth token is required to make use of this provider. 
 Each resource and data source will document the permissions (Bot Token Scopes) required to perform that operation.
+
+Bot tokens (` + "`xoxb-`" + `) and user tokens (` + "`xoxp-`" + `) are supported, including rotating ones.
+Resources and data sources built on Slack's admin API need the user token of an admin, and fail during planning when configured with a bot token.
 `,
 		Attributes: map[string]schema.Attribute{
 			"token": schema.StringAttribute{
 				MarkdownDescription: "Slack API Token. This can also be set by configuring the `SLACK_TOKEN` environment variable.",
 				Optional:            true,
 			},
+			"api_url": schema.StringAttribute{
+				MarkdownDescription: "Base URL of the Slack Web API. Defaults to `https://slack.com/api/`, or `https://slack-gov.com/api/` with `govslack`. " +
+					"This can also be set by configuring the `SLACK_API_URL` environment variable.",
+				Optional: true,
+			},
+			"govslack": schema.BoolAttribute{
+				MarkdownDescription: "Set true to use GovSlack, Slack's deployment for US public sector organizations, whose tokens only work with its own API. " +
+					"Has no effect when `api_url` is set. This can also be set by configuring the `SLACK_GOVSLACK` environment variable.",
+				Optional: true,
+			},
+			"expected_team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace, or of the Enterprise Grid organization, the token is expected to belong to. " +
+					"Configuring the provider fails when the token belongs to another, rather than managing resources in the wrong workspace. " +
+					"This can also be set by configuring the `SLACK_EXPECTED_TEAM_ID` environment variable.",
+				Optional: true,
+			},
+			"admin_channel_search": schema.BoolAttribute{
+				MarkdownDescription: "Set true to find channels by name with `admin.conversations.search` instead of listing every channel, " +
+					"which is much faster in large Enterprise Grid organizations. Needs an org-level user token of an admin with the `admin.conversations:read` scope.",
+				Optional: true,
+			},
+			"check_usergroup_conflicts": schema.BoolAttribute{
+				MarkdownDescription: "Set true to check the names and handles of `slack_usergroup` resources against existing User Groups, users and channels when planning, " +
+					"so conflicts fail the plan rather than the apply. Each check lists the workspace's User Groups, and checking handles looks up users and channels by name, " +
+					"which `cache_ttl` speeds up.",
+				Optional: true,
+			},
+			"insecure_skip_verify": schema.BoolAttribute{
+				MarkdownDescription: "Set true to skip verifying the TLS certificate of the Slack API. " +
+					"Only meant for environments behind TLS-intercepting proxies whose certificate cannot be provided with `ca_cert_pem`.",
+				Optional: true,
+			},
+			"ca_cert_pem": schema.StringAttribute{
+				MarkdownDescription: "PEM encoded CA certificates to trust in addition to the system's when connecting to the Slack API, " +
+					"such as that of a TLS-intercepting proxy.",
+				Optional: true,
+			},
+			"headers": schema.MapAttribute{
+				MarkdownDescription: "Extra HTTP headers to send with every request to the Slack API, such as those an egress proxy authenticates with, or trace IDs. " +
+					"The `Authorization`, `Content-Type` and `User-Agent` headers are set by the provider and cannot be configured.",
+				Optional:    true,
+				Sensitive:   true,
+				ElementType: types.StringType,
+			},
+			"user_agent_suffix": schema.StringAttribute{
+				MarkdownDescription: "Text appended to the User-Agent the provider sends with every request, which identifies the provider and Terraform versions, " +
+					"so that requests can be told apart in Slack's logs or by egress proxies.",
+				Optional: true,
+			},
+			"cache_ttl": schema.StringAttribute{
+				MarkdownDescription: "How long the channels, users and User Groups listed to look them up by name are cached on disk, such as `15m`, " +
+					"so repeated runs do not list them again. Resources are never read from the cache, and a data source that does not find " +
+					"what it looks for in the cache lists it again. Caching is disabled when unset.",
+				Optional: true,
+			},
+			"cache_dir": schema.StringAttribute{
+				MarkdownDescription: "Directory the cache enabled by `cache_ttl` is kept in. Defaults to `terraform-provider-slack` in the user's cache directory.",
+				Optional:            true,
+			},
+			"retry_on": schema.ListAttribute{
+				MarkdownDescription: "Slack error codes to retry API calls on, such as `internal_error`, `service_unavailable` or `fatal_error`, which fail the apply otherwise. " +
+					"Calls are retried up to 5 times, waiting around 1 second before the first retry and twice as long before each retry after, with random jitter. " +
+					"Rate limits are always waited out.",
+				Optional:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.ValueStringsAre(stringvalidator.LengthAtLeast(1)),
+				},
+			},
+			"retry_max_elapsed": schema.StringAttribute{
+				MarkdownDescription: "How long a single API call is retried for, such as `10m`, after which it fails rather than waiting out further rate limits or errors of `retry_on`. " +
+					"When unset, calls are retried for as long as Slack keeps rate limiting them.",
+				Optional: true,
+			},
+			"scope_report_file": schema.StringAttribute{
+				MarkdownDescription: "Path of a JSON file to report the OAuth scopes of the token in, split into those accepted by the Slack API methods the provider called and those that were unused, " +
+					"to help narrow the scopes of the token down. The report accumulates over runs, as plans and applies each call only some of the methods, so run both before relying on it. " +
+					"Delete the file to start over.",
+				Optional: true,
+			},
+			"redact_emails": schema.BoolAttribute{
+				MarkdownDescription: "Set true to leave the email addresses data sources read from Slack null, so they do not show in plans, state, CI logs or the cache enabled by `cache_ttl`. " +
+					"Emails used to look users up are kept as configured.",
+				Optional: true,
+			},
 		},
 	}
 }
@@ -69,8 +187,145 @@ func (p *SlackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 	if !config.Token.IsNull() {
 		token = config.Token.ValueString()
 	}
-	client := slack.New(token)
-	_, err := client.AuthTest()
+	apiURL := os.Getenv("SLACK_API_URL")
+
+	if !config.APIURL.IsNull() {
+		apiURL = config.APIURL.ValueString()
+	}
+
+	var govSlack bool
+	if value := os.Getenv("SLACK_GOVSLACK"); value != "" {
+		var err error
+		govSlack, err = strconv.ParseBool(value)
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("govslack"),
+				"Invalid GovSlack Setting",
+				"The SLACK_GOVSLACK environment variable needs to be true or false: "+err.Error(),
+			)
+		}
+	}
+
+	if !config.GovSlack.IsNull() {
+		govSlack = config.GovSlack.ValueBool()
+	}
+
+	expectedTeamId := os.Getenv("SLACK_EXPECTED_TEAM_ID")
+
+	if !config.ExpectedTeamId.IsNull() {
+		expectedTeamId = config.ExpectedTeamId.ValueString()
+	}
+
+	tokenType := detectTokenType(token)
+	resp.Diagnostics.Append(validateProviderToken(tokenType)...)
+
+	if config.AdminChannelSearch.ValueBool() && tokenType == tokenTypeBot {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("admin_channel_search"),
+			"Unsupported Slack Token",
+			"Admin channel search uses admin.conversations.search, which needs the user token (xoxp-) of an admin, "+
+				"but the provider is configured with a bot token (xoxb-).",
+		)
+	}
+
+	var cacheTTL time.Duration
+	if config.CacheTTL.ValueString() != "" {
+		var err error
+		cacheTTL, err = time.ParseDuration(config.CacheTTL.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("cache_ttl"),
+				"Invalid Cache TTL",
+				"The cache TTL needs to be a duration such as 15m or 1h: "+err.Error(),
+			)
+		}
+	}
+
+	var retryMaxElapsed time.Duration
+	if config.RetryMaxElapsed.ValueString() != "" {
+		var err error
+		retryMaxElapsed, err = time.ParseDuration(config.RetryMaxElapsed.ValueString())
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("retry_max_elapsed"),
+				"Invalid Retry Max Elapsed",
+				"The maximum time to retry API calls for needs to be a duration such as 10m or 1h: "+err.Error(),
+			)
+		}
+	}
+
+	var headers map[string]string
+	resp.Diagnostics.Append(config.Headers.ElementsAs(ctx, &headers, false)...)
+
+	for name := range headers {
+		switch http.CanonicalHeaderKey(name) {
+		case "Authorization", "Content-Type", "User-Agent":
+			resp.Diagnostics.AddAttributeError(
+				path.Root("headers"),
+				"Reserved HTTP Header",
+				"The "+name+" header is set by the provider and cannot be configured. "+
+					"Use user_agent_suffix to add to the User-Agent.",
+			)
+		}
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var options []slack.Option
+
+	apiURL = resolveAPIURL(apiURL, govSlack)
+	if apiURL != "" {
+		options = append(options, slack.OptionAPIURL(apiURL))
+	}
+
+	// Acceptance tests provide their own transport.
+	transport := p.transport
+	if transport == nil {
+		var err error
+		transport, err = newTransport(transportOptions{
+			insecureSkipVerify: config.InsecureSkipVerify.ValueBool(),
+			caCertPEM:          config.CACertPEM.ValueString(),
+		})
+		if err != nil {
+			resp.Diagnostics.AddAttributeError(
+				path.Root("ca_cert_pem"),
+				"Invalid CA Certificates",
+				"Unable to read the CA certificates: "+err.Error(),
+			)
+			return
+		}
+	}
+
+	if len(headers) > 0 {
+		transport = &headersTransport{headers: headers, next: transport}
+	}
+
+	transport = &userAgentTransport{
+		userAgent: userAgent(p.version, req.TerraformVersion, config.UserAgentSuffix.ValueString()),
+		next:      transport,
+	}
+
+	var scopeTransport *scopeUsageTransport
+	if config.ScopeReportFile.ValueString() != "" {
+		scopeTransport = &scopeUsageTransport{next: transport}
+		transport = scopeTransport
+	}
+
+	httpClient := &http.Client{Transport: newLoggingTransport(transport)}
+	options = append(options, slack.OptionHTTPClient(httpClient))
+
+	client := slack.New(token, options...)
+	slackClient := NewSlackClient(client)
+	slackClient.token = token
+	slackClient.apiURL = apiURL
+	slackClient.httpClient = httpClient
+	slackClient.tokenType = tokenType
+	resp.Diagnostics.Append(config.RetryOn.ElementsAs(ctx, &slackClient.retryOn, false)...)
+	slackClient.retryMaxElapsed = retryMaxElapsed
+
+	auth, err := slackClient.authTest(ctx)
 	if err != nil {
 		resp.Diagnostics.AddError(
 			"Unable to Configure Slack Client",
@@ -80,29 +335,119 @@ func (p *SlackProvider) Configure(ctx context.Context, req provider.ConfigureReq
 		)
 		return
 	}
-	resp.DataSourceData = client
-	resp.ResourceData = client
+	if expectedTeamId != "" && expectedTeamId != auth.TeamID && expectedTeamId != auth.EnterpriseID {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expected_team_id"),
+			"Unexpected Slack Workspace",
+			"The token belongs to workspace "+auth.TeamID+" ("+auth.Team+"), but workspace "+expectedTeamId+" was expected. "+
+				"Check that the provider is configured with the token of the right workspace.",
+		)
+		return
+	}
+
+	slackClient.teamId = auth.TeamID
+	slackClient.userId = auth.UserID
+	slackClient.scopes = auth.Scopes
+
+	if scopeTransport != nil {
+		scopeTransport.usage = newScopeUsage(ctx, config.ScopeReportFile.ValueString(), auth.TeamID, auth.Scopes)
+	}
+
+	if cacheTTL > 0 {
+		cacheDir := config.CacheDir.ValueString()
+		if cacheDir == "" {
+			cacheDir, err = defaultCacheDir()
+			if err != nil {
+				resp.Diagnostics.AddAttributeError(
+					path.Root("cache_dir"),
+					"Unable to Find Cache Directory",
+					"The user's cache directory could not be found, so cache_dir needs to be set: "+err.Error(),
+				)
+				return
+			}
+		}
+
+		slackClient.cache = &readCache{dir: cacheDir, ttl: cacheTTL, teamId: auth.TeamID}
+	}
+	slackClient.adminChannelSearch = config.AdminChannelSearch.ValueBool()
+	slackClient.checkUserGroupConflicts = config.CheckUserGroupConflicts.ValueBool()
+	slackClient.redactEmails = config.RedactEmails.ValueBool()
+
+	resp.DataSourceData = slackClient
+	resp.ResourceData = slackClient
+	resp.ListResourceData = slackClient
+	resp.EphemeralResourceData = slackClient
 }
 
 func (p *SlackProvider) Resources(ctx context.Context) []func() resource.Resource {
 	return []func() resource.Resource{
+		NewAppRestrictionResource,
 		NewChannelResource,
+		NewChannelArchiveResource,
+		NewChannelBulkArchiveResource,
+		NewChannelDisconnectResource,
+		NewEmojiAliasResource,
+		NewChannelJoinResource,
+		NewChannelTopicResource,
+		NewChannelWorkspacesResource,
+		NewConversationOpenResource,
+		NewFunctionDistributionResource,
+		NewNotificationResource,
+		NewRemoteFileResource,
 		NewUserGroupResource,
+		NewUserGroupChannelResource,
+		NewUserSessionResetResource,
+		NewUserSessionSettingsResource,
+		NewUserWorkspaceAssignmentResource,
+	}
+}
+
+func (p *SlackProvider) EphemeralResources(ctx context.Context) []func() ephemeral.EphemeralResource {
+	return []func() ephemeral.EphemeralResource{
+		NewTokenEphemeralResource,
+	}
+}
+
+func (p *SlackProvider) ListResources(ctx context.Context) []func() list.ListResource {
+	return []func() list.ListResource{
+		NewChannelListResource,
+		NewUserGroupListResource,
 	}
 }
 
 func (p *SlackProvider) DataSources(ctx context.Context) []func() datasource.DataSource {
 	return []func() datasource.DataSource{
+		NewAdminConversationsDataSource,
+		NewAdminUsersDataSource,
+		NewAppManifestDataSource,
+		NewAppRequestsDataSource,
+		NewApprovedAppsDataSource,
 		NewChannelDataSource,
 		NewChannelMembersDataSource,
+		NewChannelMembershipDataSource,
+		NewChannelPrefsDataSource,
+		NewConnectInvitesDataSource,
+		NewEnterpriseDataSource,
+		NewReactionsDataSource,
+		NewSharedChannelsDataSource,
+		NewTokenScopesDataSource,
 		NewUserDataSource,
+		NewUserUserGroupsDataSource,
+		NewUserSessionsDataSource,
 		NewUserGroupDataSource,
+		NewUserGroupMembershipDataSource,
+		NewWorkspaceAdminsDataSource,
 	}
 }
 
 func (p *SlackProvider) Functions(ctx context.Context) []func() function.Function {
 	return []func() function.Function{
-		// NewExampleFunction,
+		NewIsChannelIdFunction,
+		NewIsUserGroupIdFunction,
+		NewIsUserIdFunction,
+		NewMrkdwnLinkFunction,
+		NewParseSlackTsFunction,
+		NewValidateEmojiNameFunction,
 	}
 }
 
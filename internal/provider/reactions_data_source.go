@@ -0,0 +1,183 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &ReactionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &ReactionsDataSource{}
+)
+
+func NewReactionsDataSource() datasource.DataSource {
+	return &ReactionsDataSource{}
+}
+
+// ReactionsDataSource defines the data source implementation.
+type ReactionsDataSource struct {
+	client *SlackClient
+}
+
+// ReactionsDataSourceModel describes the data source data model.
+type ReactionsDataSourceModel struct {
+	Id        types.String `tfsdk:"id"`
+	ChannelId types.String `tfsdk:"channel_id"`
+	Timestamp types.String `tfsdk:"timestamp"`
+	Reactions types.List   `tfsdk:"reactions"`
+}
+
+// ReactionModel describes a single entry of reactions.
+type ReactionModel struct {
+	Name    types.String `tfsdk:"name"`
+	Count   types.Int64  `tfsdk:"count"`
+	UserIds types.List   `tfsdk:"user_ids"`
+}
+
+var reactionAttrTypes = map[string]attr.Type{
+	"name":     types.StringType,
+	"count":    types.Int64Type,
+	"user_ids": types.ListType{ElemType: types.StringType},
+}
+
+func (d *ReactionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_reactions"
+}
+
+func (d *ReactionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the reactions to a message, such as to only apply a change once it was approved with a reaction.
+### Required Permissions
+- ` + "`reactions:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the message, in the form `channel_id:timestamp`.",
+				Computed:            true,
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the channel the message was posted in.",
+				Required:            true,
+			},
+			"timestamp": schema.StringAttribute{
+				MarkdownDescription: "The timestamp of the message, such as the `id` of `slack_notification`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(slackTsRegexp, "must be a Slack timestamp such as 1712345678.000200"),
+				},
+			},
+			"reactions": schema.ListNestedAttribute{
+				MarkdownDescription: "The reactions to the message.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The name of the emoji reacted with, without colons.",
+							Computed:            true,
+						},
+						"count": schema.Int64Attribute{
+							MarkdownDescription: "How many users reacted with the emoji.",
+							Computed:            true,
+						},
+						"user_ids": schema.ListAttribute{
+							MarkdownDescription: "IDs of the users who reacted with the emoji.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *ReactionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *ReactionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data ReactionsDataSourceModel
+	client := d.client
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var item slack.ReactedItem
+
+	// Without full, Slack leaves out some of the users of popular reactions.
+	err := client.retry(ctx, "reactions.get", func() (err error) {
+		item, err = client.GetReactionsContext(
+			ctx,
+			slack.NewRefToMessage(data.ChannelId.ValueString(), data.Timestamp.ValueString()),
+			slack.GetReactionsParameters{Full: true},
+		)
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read reactions, got error: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	reactions := []ReactionModel{}
+	for _, reaction := range item.Reactions {
+		userIds, d := types.ListValueFrom(ctx, types.StringType, append([]string{}, reaction.Users...))
+		diags.Append(d...)
+
+		reactions = append(reactions, ReactionModel{
+			Name:    types.StringValue(reaction.Name),
+			Count:   types.Int64Value(int64(reaction.Count)),
+			UserIds: userIds,
+		})
+	}
+	resp.Diagnostics.Append(diags...)
+
+	// Set data from API response.
+	data.Id = types.StringValue(data.ChannelId.ValueString() + ":" + data.Timestamp.ValueString())
+	data.Reactions, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: reactionAttrTypes}, reactions)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
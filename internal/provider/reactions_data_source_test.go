@@ -0,0 +1,38 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccReactionsDataSource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvChannelId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+resource "slack_notification" "test" {
+  channel = "` + channelId + `"
+  text    = "Approve the deploy with :white_check_mark:"
+}
+
+data "slack_reactions" "test" {
+  channel_id = "` + channelId + `"
+  timestamp  = slack_notification.test.id
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_reactions.test", "id"),
+					resource.TestCheckResourceAttr("data.slack_reactions.test", "reactions.#", "0"),
+				),
+			},
+		},
+	})
+}
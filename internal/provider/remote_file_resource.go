@@ -0,0 +1,284 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &RemoteFileResource{}
+var _ resource.ResourceWithImportState = &RemoteFileResource{}
+
+func NewRemoteFileResource() resource.Resource {
+	return &RemoteFileResource{}
+}
+
+// RemoteFileResource defines the resource implementation.
+type RemoteFileResource struct {
+	client *SlackClient
+}
+
+// RemoteFileResourceModel describes the resource data model.
+type RemoteFileResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	ExternalId            types.String `tfsdk:"external_id"`
+	ExternalUrl           types.String `tfsdk:"external_url"`
+	Title                 types.String `tfsdk:"title"`
+	Filetype              types.String `tfsdk:"filetype"`
+	IndexableFileContents types.String `tfsdk:"indexable_file_contents"`
+	PreviewImage          types.String `tfsdk:"preview_image"`
+	Permalink             types.String `tfsdk:"permalink"`
+}
+
+func (r *RemoteFileResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_remote_file"
+}
+
+func (r *RemoteFileResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Registers an external document, such as a Google Doc or a Confluence page, as a Slack remote file.
+Remote files are not shared to any channel when added.
+### Required Permissions
+- ` + "`remote_files:write`" + `
+- ` + "`remote_files:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "Slack's ID of the remote file.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"external_id": schema.StringAttribute{
+				MarkdownDescription: "A unique ID for the file in the system it comes from.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"external_url": schema.StringAttribute{
+				MarkdownDescription: "URL of the document.",
+				Required:            true,
+			},
+			"title": schema.StringAttribute{
+				MarkdownDescription: "Title of the file.",
+				Required:            true,
+			},
+			"filetype": schema.StringAttribute{
+				MarkdownDescription: "Type of the file, such as `gdoc`. Slack detects it when unset.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"indexable_file_contents": schema.StringAttribute{
+				MarkdownDescription: "Text of the file to make it searchable in Slack. Slack does not return it, so changes made outside of Terraform are not detected.",
+				Optional:            true,
+			},
+			"preview_image": schema.StringAttribute{
+				MarkdownDescription: "Path to a local image to show as the file's preview. Only the path is tracked, so changing the image without changing the path is not detected.",
+				Optional:            true,
+			},
+			"permalink": schema.StringAttribute{
+				MarkdownDescription: "Permalink of the file in Slack.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *RemoteFileResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// remoteFileParameters returns the files.remote.add and files.remote.update
+// parameters for data.
+func remoteFileParameters(data RemoteFileResourceModel) slack.RemoteFileParameters {
+	return slack.RemoteFileParameters{
+		ExternalID:            data.ExternalId.ValueString(),
+		ExternalURL:           data.ExternalUrl.ValueString(),
+		Title:                 data.Title.ValueString(),
+		Filetype:              data.Filetype.ValueString(),
+		IndexableFileContents: data.IndexableFileContents.ValueString(),
+		PreviewImage:          data.PreviewImage.ValueString(),
+	}
+}
+
+func (r *RemoteFileResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data RemoteFileResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var file *slack.RemoteFile
+
+	err := client.retry(ctx, "files.remote.add", func() (err error) {
+		file, err = client.AddRemoteFileContext(ctx, remoteFileParameters(data))
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add remote file: %s, got error: %s", data.ExternalId.ValueString(), err))
+		return
+	}
+
+	data.Id = types.StringValue(file.ID)
+	data.Filetype = types.StringValue(file.Filetype)
+	data.Permalink = types.StringValue(file.Permalink)
+
+	tflog.Trace(ctx, "Added a slack remote file", map[string]any{"file_id": file.ID})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteFileResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data RemoteFileResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var file *slack.RemoteFile
+
+	err := client.retry(ctx, "files.remote.info", func() (err error) {
+		file, err = client.GetRemoteFileInfoContext(ctx, "", data.Id.ValueString())
+		return err
+	})
+
+	if err != nil {
+		if err.Error() == "file_not_found" || err.Error() == "file_deleted" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read remote file: %s, got error: %s", data.Id.ValueString(), err))
+		return
+	}
+
+	data.ExternalId = types.StringValue(file.ExternalID)
+	data.ExternalUrl = types.StringValue(file.ExternalURL)
+	data.Title = types.StringValue(file.Title)
+	data.Filetype = types.StringValue(file.Filetype)
+	data.Permalink = types.StringValue(file.Permalink)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *RemoteFileResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan, state RemoteFileResourceModel
+	client := r.client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The file is identified by its ID, so the external ID is left out.
+	params := remoteFileParameters(plan)
+	params.ExternalID = ""
+
+	var file *slack.RemoteFile
+
+	err := client.retry(ctx, "files.remote.update", func() (err error) {
+		file, err = client.UpdateRemoteFileContext(ctx, state.Id.ValueString(), params)
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update remote file: %s, got error: %s", state.Id.ValueString(), err))
+		return
+	}
+
+	plan.Filetype = types.StringValue(file.Filetype)
+	plan.Permalink = types.StringValue(file.Permalink)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *RemoteFileResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data RemoteFileResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "files.remote.remove", func() error {
+		return client.RemoveRemoteFileContext(ctx, "", data.Id.ValueString())
+	})
+
+	if err != nil {
+		if err.Error() == "file_not_found" || err.Error() == "file_deleted" {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove remote file, got error: %s", err))
+		return
+	}
+}
+
+func (r *RemoteFileResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
@@ -0,0 +1,100 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func init() {
+	resource.AddTestSweepers("slack_remote_file", &resource.Sweeper{
+		Name: "slack_remote_file",
+		F:    sweepRemoteFiles,
+	})
+}
+
+// testSweepRemoteFileExternalId matches the remote files created by TestAccRemoteFileResource.
+var testSweepRemoteFileExternalId = regexp.MustCompile(`^test-remote-file-[a-z]{6}$`)
+
+func sweepRemoteFiles(_ string) error {
+	ctx := context.Background()
+	client := sweeperClient()
+
+	files, err := client.ListRemoteFilesContext(ctx, slack.ListRemoteFilesParameters{
+		Limit:       slack.DEFAULT_REMOTE_FILES_COUNT,
+		TimestampTo: slack.DEFAULT_REMOTE_FILES_TS_TO,
+	})
+	if err != nil {
+		return err
+	}
+
+	for _, file := range files {
+		if !testSweepRemoteFileExternalId.MatchString(file.ExternalID) {
+			continue
+		}
+
+		err := client.retry(ctx, "files.remote.remove", func() error {
+			return client.RemoveRemoteFileContext(ctx, "", file.ID)
+		})
+		if err != nil {
+			return fmt.Errorf("unable to remove remote file %s (%s): %s", file.ExternalID, file.ID, err)
+		}
+	}
+
+	return nil
+}
+
+func TestAccRemoteFileResource(t *testing.T) {
+	externalId := "test-remote-file-" + testAccNameSuffix(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccRemoteFileResourceConfig(externalId, "Runbook"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("slack_remote_file.test", "id"),
+					resource.TestCheckResourceAttrSet("slack_remote_file.test", "permalink"),
+					resource.TestCheckResourceAttr("slack_remote_file.test", "external_id", externalId),
+					resource.TestCheckResourceAttr("slack_remote_file.test", "title", "Runbook"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:            "slack_remote_file.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"indexable_file_contents"},
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + testAccRemoteFileResourceConfig(externalId, "Runbook v2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_remote_file.test", "external_id", externalId),
+					resource.TestCheckResourceAttr("slack_remote_file.test", "title", "Runbook v2"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccRemoteFileResourceConfig(externalId string, title string) string {
+	return `
+resource "slack_remote_file" "test" {
+  external_id             = "` + externalId + `"
+  external_url            = "https://example.com/docs/` + externalId + `"
+  title                   = "` + title + `"
+  indexable_file_contents = "On-call runbook"
+}
+`
+}
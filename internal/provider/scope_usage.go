@@ -0,0 +1,163 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"maps"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// scopeReport is the report of the OAuth scopes exercised by the provider
+// that scope_report_file is written with.
+type scopeReport struct {
+	TeamId string `json:"team_id"`
+
+	// GrantedScopes are the scopes of the provider's token.
+	GrantedScopes []string `json:"granted_scopes"`
+
+	// ExercisedScopes are the granted scopes accepted by any of the Slack API
+	// methods called, and UnusedScopes the granted scopes that are not.
+	ExercisedScopes []string `json:"exercised_scopes"`
+	UnusedScopes    []string `json:"unused_scopes"`
+
+	// Methods maps the Slack API methods called to the scopes they accept,
+	// any one of which is needed to call them.
+	Methods map[string][]string `json:"methods"`
+}
+
+// scopeUsage records the scopes accepted by the Slack API methods the
+// provider calls, which Slack reports in the X-Accepted-OAuth-Scopes header,
+// and keeps a report of them in a file. The report accumulates over runs, as
+// plan and apply each call only some of the methods a configuration needs.
+type scopeUsage struct {
+	mu     sync.Mutex
+	path   string
+	report scopeReport
+}
+
+// newScopeUsage returns a scopeUsage reporting to the file at path, merging
+// the methods of an existing report of the same workspace. The report is
+// written right away, so it reflects the token's current scopes.
+func newScopeUsage(ctx context.Context, path string, teamId string, grantedScopes []string) *scopeUsage {
+	usage := &scopeUsage{path: path}
+
+	var existing scopeReport
+	if data, err := os.ReadFile(path); err == nil && json.Unmarshal(data, &existing) == nil && existing.TeamId == teamId {
+		usage.report.Methods = existing.Methods
+	}
+	if usage.report.Methods == nil {
+		usage.report.Methods = map[string][]string{}
+	}
+
+	usage.report.TeamId = teamId
+	usage.report.GrantedScopes = slices.Sorted(slices.Values(grantedScopes))
+	usage.write(ctx)
+	return usage
+}
+
+// record records the scopes method accepts, writing the report if the method
+// was not called before.
+func (u *scopeUsage) record(ctx context.Context, method string, acceptedScopes []string) {
+	u.mu.Lock()
+	defer u.mu.Unlock()
+
+	if _, ok := u.report.Methods[method]; ok {
+		return
+	}
+	u.report.Methods[method] = slices.Sorted(slices.Values(acceptedScopes))
+
+	tflog.Debug(ctx, "Slack API method called for the first time", map[string]any{
+		"endpoint":        method,
+		"accepted_scopes": strings.Join(acceptedScopes, ","),
+	})
+
+	u.write(ctx)
+}
+
+// write writes the report. Failing to do so does not affect the run, so
+// errors are logged rather than returned.
+func (u *scopeUsage) write(ctx context.Context) {
+	exercised := map[string]bool{}
+	for _, acceptedScopes := range u.report.Methods {
+		for _, scope := range acceptedScopes {
+			if slices.Contains(u.report.GrantedScopes, scope) {
+				exercised[scope] = true
+			}
+		}
+	}
+
+	u.report.ExercisedScopes = slices.Sorted(maps.Keys(exercised))
+	u.report.UnusedScopes = slices.DeleteFunc(slices.Clone(u.report.GrantedScopes), func(scope string) bool {
+		return exercised[scope]
+	})
+
+	err := func() error {
+		data, err := json.MarshalIndent(u.report, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		// Write to a temporary file first, so the report is never read
+		// partially written.
+		file, err := os.CreateTemp(filepath.Dir(u.path), "*.tmp")
+		if err != nil {
+			return err
+		}
+		defer os.Remove(file.Name())
+
+		if _, err := file.Write(data); err != nil {
+			file.Close()
+			return err
+		}
+		if err := file.Close(); err != nil {
+			return err
+		}
+		return os.Rename(file.Name(), u.path)
+	}()
+
+	if err != nil {
+		tflog.Warn(ctx, "Unable to write scope report", map[string]any{
+			"path":  u.path,
+			"error": err.Error(),
+		})
+	}
+}
+
+// scopeUsageTransport records the scopes accepted by the Slack API methods
+// called through it. Usage is set once the provider's workspace and scopes
+// are known, and nothing is recorded before.
+type scopeUsageTransport struct {
+	usage *scopeUsage
+	next  http.RoundTripper
+}
+
+func (t *scopeUsageTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	resp, err := t.next.RoundTrip(req)
+	if err != nil {
+		return resp, err
+	}
+
+	// Methods that need no scopes, such as auth.test, report none.
+	if header := resp.Header.Get("X-Accepted-OAuth-Scopes"); t.usage != nil && header != "" {
+		var acceptedScopes []string
+		for _, scope := range strings.Split(header, ",") {
+			if scope = strings.TrimSpace(scope); scope != "" {
+				acceptedScopes = append(acceptedScopes, scope)
+			}
+		}
+		t.usage.record(req.Context(), path.Base(req.URL.Path), acceptedScopes)
+	}
+
+	return resp, err
+}
@@ -0,0 +1,77 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"slices"
+	"testing"
+)
+
+func TestScopeUsageTransport(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/api/conversations.info" {
+			w.Header().Set("X-Accepted-OAuth-Scopes", "channels:read,groups:read")
+		}
+	}))
+	defer server.Close()
+
+	reportPath := filepath.Join(t.TempDir(), "scopes.json")
+	granted := []string{"channels:read", "users:read", "chat:write"}
+
+	call := func(usage *scopeUsage, method string) {
+		t.Helper()
+		client := &http.Client{Transport: &scopeUsageTransport{usage: usage, next: http.DefaultTransport}}
+		resp, err := client.Post(server.URL+"/api/"+method, "application/x-www-form-urlencoded", nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+	}
+	readReport := func() scopeReport {
+		t.Helper()
+		data, err := os.ReadFile(reportPath)
+		if err != nil {
+			t.Fatal(err)
+		}
+		var report scopeReport
+		if err := json.Unmarshal(data, &report); err != nil {
+			t.Fatal(err)
+		}
+		return report
+	}
+
+	// Nothing is recorded before the workspace is known.
+	call(nil, "conversations.info")
+
+	call(newScopeUsage(context.Background(), reportPath, "T1", granted), "conversations.info")
+
+	report := readReport()
+	if !slices.Equal(report.ExercisedScopes, []string{"channels:read"}) {
+		t.Errorf("expected channels:read to be exercised, got: %v", report.ExercisedScopes)
+	}
+	if !slices.Equal(report.UnusedScopes, []string{"chat:write", "users:read"}) {
+		t.Errorf("expected chat:write and users:read to be unused, got: %v", report.UnusedScopes)
+	}
+	if !slices.Equal(report.Methods["conversations.info"], []string{"channels:read", "groups:read"}) {
+		t.Errorf("expected the scopes conversations.info accepts to be reported, got: %v", report.Methods)
+	}
+
+	// Methods that report no scopes are not recorded, and later runs add to
+	// the report of the same workspace.
+	call(newScopeUsage(context.Background(), reportPath, "T1", granted), "auth.test")
+	if report := readReport(); len(report.Methods) != 1 || report.ExercisedScopes[0] != "channels:read" {
+		t.Errorf("expected the methods of the previous run to be kept, got: %v", report.Methods)
+	}
+
+	newScopeUsage(context.Background(), reportPath, "T2", granted)
+	if report := readReport(); len(report.Methods) != 0 {
+		t.Errorf("expected the methods of another workspace not to be kept, got: %v", report.Methods)
+	}
+}
@@ -0,0 +1,246 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &SharedChannelsDataSource{}
+	_ datasource.DataSourceWithConfigure = &SharedChannelsDataSource{}
+)
+
+func NewSharedChannelsDataSource() datasource.DataSource {
+	return &SharedChannelsDataSource{}
+}
+
+// SharedChannelsDataSource defines the data source implementation.
+type SharedChannelsDataSource struct {
+	client *SlackClient
+}
+
+// SharedChannelsDataSourceModel describes the data source data model.
+type SharedChannelsDataSourceModel struct {
+	Id               types.String `tfsdk:"id"`
+	TeamIds          types.Set    `tfsdk:"team_ids"`
+	ConnectedTeamIds types.Set    `tfsdk:"connected_team_ids"`
+	ExcludeArchived  types.Bool   `tfsdk:"exclude_archived"`
+	ChannelIds       types.List   `tfsdk:"channel_ids"`
+	Channels         types.List   `tfsdk:"channels"`
+}
+
+// SharedChannelModel describes a single entry of channels.
+type SharedChannelModel struct {
+	Id                      types.String `tfsdk:"id"`
+	Name                    types.String `tfsdk:"name"`
+	IsPrivate               types.Bool   `tfsdk:"is_private"`
+	IsArchived              types.Bool   `tfsdk:"is_archived"`
+	ConnectedTeamIds        types.Set    `tfsdk:"connected_team_ids"`
+	PendingConnectedTeamIds types.Set    `tfsdk:"pending_connected_team_ids"`
+	ExternalUserCount       types.Int64  `tfsdk:"external_user_count"`
+}
+
+var sharedChannelAttrTypes = map[string]attr.Type{
+	"id":                         types.StringType,
+	"name":                       types.StringType,
+	"is_private":                 types.BoolType,
+	"is_archived":                types.BoolType,
+	"connected_team_ids":         types.SetType{ElemType: types.StringType},
+	"pending_connected_team_ids": types.SetType{ElemType: types.StringType},
+	"external_user_count":        types.Int64Type,
+}
+
+func (d *SharedChannelsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_shared_channels"
+}
+
+func (d *SharedChannelsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Lists the Slack Connect channels of a workspace or organization, shared with external organizations, and who they are
+shared with, such as for periodic audits of Slack Connect.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+- ` + "`admin.conversations:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace or organization of the token used.",
+				Computed:            true,
+			},
+			"team_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the workspaces to list the channels of. Org-level tokens list those of the whole organization when unset.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"connected_team_ids": schema.SetAttribute{
+				MarkdownDescription: "Only list channels shared with these external workspaces or organizations.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"exclude_archived": schema.BoolAttribute{
+				MarkdownDescription: "Set true to leave archived channels out of the results.",
+				Optional:            true,
+			},
+			"channel_ids": schema.ListAttribute{
+				MarkdownDescription: "IDs of the shared channels.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"channels": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each shared channel.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The channel's ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The channel's name.",
+							Computed:            true,
+						},
+						"is_private": schema.BoolAttribute{
+							MarkdownDescription: "Whether the channel is private.",
+							Computed:            true,
+						},
+						"is_archived": schema.BoolAttribute{
+							MarkdownDescription: "Whether the channel is archived.",
+							Computed:            true,
+						},
+						"connected_team_ids": schema.SetAttribute{
+							MarkdownDescription: "IDs of the external workspaces and organizations the channel is shared with.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"pending_connected_team_ids": schema.SetAttribute{
+							MarkdownDescription: "IDs of the external workspaces and organizations invited to the channel that have not joined yet.",
+							Computed:            true,
+							ElementType:         types.StringType,
+						},
+						"external_user_count": schema.Int64Attribute{
+							MarkdownDescription: "The number of members of the channel from external organizations.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *SharedChannelsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_shared_channels")...)
+}
+
+func (d *SharedChannelsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data SharedChannelsDataSourceModel
+	client := d.client
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var teamIds, connectedTeamIds []string
+	resp.Diagnostics.Append(data.TeamIds.ElementsAs(ctx, &teamIds, false)...)
+	resp.Diagnostics.Append(data.ConnectedTeamIds.ElementsAs(ctx, &connectedTeamIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	searchChannelTypes := []string{"external_shared"}
+	if data.ExcludeArchived.ValueBool() {
+		searchChannelTypes = append(searchChannelTypes, "exclude_archived")
+	}
+
+	options := []slack.AdminConversationsSearchOption{
+		slack.AdminConversationsSearchOptionSearchChannelTypes(searchChannelTypes),
+	}
+	if len(teamIds) > 0 {
+		options = append(options, slack.AdminConversationsSearchOptionTeamIDs(teamIds))
+	}
+	if len(connectedTeamIds) > 0 {
+		options = append(options, slack.AdminConversationsSearchOptionConnectedTeamIDs(connectedTeamIds))
+	}
+
+	conversations, _, err := searchAdminConversations(ctx, client, "", 0, options...)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to search shared channels, got error: %s", err))
+		return
+	}
+
+	var diags diag.Diagnostics
+
+	channelIds := []string{}
+	channels := []SharedChannelModel{}
+
+	for _, conversation := range conversations {
+		connected, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, conversation.ConnectedTeamIDs...))
+		diags.Append(d...)
+		pending, d := types.SetValueFrom(ctx, types.StringType, append([]string{}, conversation.PendingConnectedTeamIDs...))
+		diags.Append(d...)
+
+		channelIds = append(channelIds, conversation.ID)
+		channels = append(channels, SharedChannelModel{
+			Id:                      types.StringValue(conversation.ID),
+			Name:                    types.StringValue(conversation.Name),
+			IsPrivate:               types.BoolValue(conversation.IsPrivate),
+			IsArchived:              types.BoolValue(conversation.IsArchived),
+			ConnectedTeamIds:        connected,
+			PendingConnectedTeamIds: pending,
+			ExternalUserCount:       types.Int64Value(int64(conversation.ExternalUserCount)),
+		})
+	}
+	resp.Diagnostics.Append(diags...)
+
+	// Set data from API response.
+	data.Id = types.StringValue(client.teamId)
+
+	data.ChannelIds, diags = types.ListValueFrom(ctx, types.StringType, channelIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.Channels, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: sharedChannelAttrTypes}, channels)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
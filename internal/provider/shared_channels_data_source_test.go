@@ -0,0 +1,38 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccSharedChannelsDataSource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvSharedChannelId)
+	teamId := testAccFixture(t, testEnvSharedTeamId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_shared_channels" "test" {
+  connected_team_ids = ["` + teamId + `"]
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckTypeSetElemAttr("data.slack_shared_channels.test", "channel_ids.*", channelId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_shared_channels.test", "channels.*", map[string]string{
+						"id":                   channelId,
+						"is_archived":          "false",
+						"connected_team_ids.#": "1",
+					}),
+					resource.TestCheckTypeSetElemAttr("data.slack_shared_channels.test", "channels.*.connected_team_ids.*", teamId),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,84 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &SlackIdFunction{}
+
+func NewIsUserIdFunction() function.Function {
+	return &SlackIdFunction{name: "is_user_id", kind: "user", prefixes: "UW", example: "U0123ABCDEF"}
+}
+
+func NewIsChannelIdFunction() function.Function {
+	return &SlackIdFunction{name: "is_channel_id", kind: "channel", prefixes: "CGD", example: "C0123ABCDEF"}
+}
+
+func NewIsUserGroupIdFunction() function.Function {
+	return &SlackIdFunction{name: "is_usergroup_id", kind: "User Group", prefixes: "S", example: "S0123ABCDEF"}
+}
+
+// SlackIdFunction defines the implementation of the functions that check
+// whether a value is the Slack ID of a kind of object, told apart by the
+// first letter of its IDs.
+type SlackIdFunction struct {
+	name     string
+	kind     string
+	prefixes string
+	example  string
+}
+
+// isSlackId reports whether id is a Slack ID starting with one of the given
+// prefix letters: the prefix followed by at least 8 uppercase letters and
+// digits.
+func isSlackId(id string, prefixes string) bool {
+	return regexp.MustCompile(`^[` + prefixes + `][A-Z0-9]{8,}$`).MatchString(id)
+}
+
+func (f *SlackIdFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = f.name
+}
+
+func (f *SlackIdFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	prefixes := "`" + f.prefixes[:1] + "`"
+	for i := 1; i < len(f.prefixes); i++ {
+		separator := ", "
+		if i == len(f.prefixes)-1 {
+			separator = " or "
+		}
+		prefixes += separator + "`" + f.prefixes[i:i+1] + "`"
+	}
+
+	resp.Definition = function.Definition{
+		Summary: "Checks whether a value is a Slack " + f.kind + " ID",
+		MarkdownDescription: "Returns whether the given value has the format of a Slack " + f.kind + " ID, such as `" + f.example + "`: " +
+			prefixes + " followed by uppercase letters and digits. It only checks the format, not that the " + f.kind + " exists, " +
+			"so modules can validate IDs passed to them during planning.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "id",
+				MarkdownDescription: "The value to check.",
+			},
+		},
+		Return: function.BoolReturn{},
+	}
+}
+
+func (f *SlackIdFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var id string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &id))
+
+	if resp.Error != nil {
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, isSlackId(id, f.prefixes)))
+}
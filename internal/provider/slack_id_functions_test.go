@@ -0,0 +1,67 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestIsSlackId(t *testing.T) {
+	for _, test := range []struct {
+		id       string
+		prefixes string
+		expected bool
+	}{
+		{id: "U0123ABCDEF", prefixes: "UW", expected: true},
+		{id: "W0123ABCD", prefixes: "UW", expected: true},
+		{id: "C0123ABCDEF", prefixes: "UW", expected: false},
+		{id: "u0123abcdef", prefixes: "UW", expected: false},
+		{id: "U0123", prefixes: "UW", expected: false},
+		{id: "G0123ABCDEF", prefixes: "CGD", expected: true},
+		{id: "D0123ABCDEF", prefixes: "CGD", expected: true},
+		{id: " C0123ABCDEF", prefixes: "CGD", expected: false},
+		{id: "S0123ABCDEF", prefixes: "S", expected: true},
+		{id: "", prefixes: "S", expected: false},
+	} {
+		if isSlackId(test.id, test.prefixes) != test.expected {
+			t.Errorf("expected %q with prefixes %s to be an ID: %t", test.id, test.prefixes, test.expected)
+		}
+	}
+}
+
+func TestAccSlackIdFunctions(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "user" {
+  value = provider::slack::is_user_id("U0123ABCDEF")
+}
+
+output "channel" {
+  value = provider::slack::is_channel_id("U0123ABCDEF")
+}
+
+output "usergroup" {
+  value = provider::slack::is_usergroup_id("S0123ABCDEF")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("user", knownvalue.Bool(true)),
+					statecheck.ExpectKnownOutputValue("channel", knownvalue.Bool(false)),
+					statecheck.ExpectKnownOutputValue("usergroup", knownvalue.Bool(true)),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,235 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// Ensure the implementation satisfies the expected interfaces.
+var _ basetypes.StringTypable = SlackTextType{}
+var _ basetypes.StringValuableWithSemanticEquals = SlackTextValue{}
+
+// SlackTextType is a string attribute type for text Slack rewrites on save,
+// such as channel topics and purposes. Slack wraps links and mentions in
+// angle brackets and escapes &, < and >, so values read back differ from
+// those configured. Values that only differ in this way are semantically
+// equal, which keeps them from showing as drift.
+type SlackTextType struct {
+	basetypes.StringType
+}
+
+func (t SlackTextType) String() string {
+	return "SlackTextType"
+}
+
+func (t SlackTextType) Equal(o attr.Type) bool {
+	other, ok := o.(SlackTextType)
+	if !ok {
+		return false
+	}
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t SlackTextType) ValueFromString(ctx context.Context, in basetypes.StringValue) (basetypes.StringValuable, diag.Diagnostics) {
+	return SlackTextValue{StringValue: in}, nil
+}
+
+func (t SlackTextType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	return SlackTextValue{StringValue: stringValue}, nil
+}
+
+func (t SlackTextType) ValueType(ctx context.Context) attr.Value {
+	return SlackTextValue{}
+}
+
+// SlackTextValue is a value of SlackTextType.
+type SlackTextValue struct {
+	basetypes.StringValue
+}
+
+func NewSlackTextValue(value string) SlackTextValue {
+	return SlackTextValue{StringValue: basetypes.NewStringValue(value)}
+}
+
+func (v SlackTextValue) Type(ctx context.Context) attr.Type {
+	return SlackTextType{}
+}
+
+func (v SlackTextValue) Equal(o attr.Value) bool {
+	other, ok := o.(SlackTextValue)
+	if !ok {
+		return false
+	}
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v SlackTextValue) StringSemanticEquals(ctx context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(SlackTextValue)
+	if !ok {
+		diags.AddError(
+			"Semantic Equality Check Error",
+			fmt.Sprintf("Expected value type %T, got: %T. Please report this issue to the provider developers.", v, newValuable),
+		)
+		return false, diags
+	}
+
+	return slackTextEqual(v.ValueString(), newValue.ValueString()), diags
+}
+
+// slackTextEntity matches Slack's markup for links and mentions, such as
+// <https://example.com>, <https://example.com|example>, <#C123|general> and
+// <!here>.
+var slackTextEntity = regexp.MustCompile(`<([^<>|]*)(?:\|([^<>]*))?>`)
+
+// slackTextSpecialMentions maps Slack's special mentions to how they are
+// typed.
+var slackTextSpecialMentions = map[string]string{
+	"!here":     "@here",
+	"!channel":  "@channel",
+	"!everyone": "@everyone",
+}
+
+// slackTextUnescape undoes the escaping of &, < and > Slack applies on save.
+var slackTextUnescape = strings.NewReplacer("&lt;", "<", "&gt;", ">", "&amp;", "&")
+
+// slackTextSpan is where an entity ended up in text rendered by
+// renderSlackText.
+type slackTextSpan struct {
+	start, end int
+	target     string
+
+	// typeable is whether Slack could have produced the entity from its
+	// rendering typed as plain text, such as by linking a URL or resolving
+	// a #channel mention.
+	typeable bool
+}
+
+// normalizeSlackText renders text as it would have been typed, undoing the
+// markup Slack adds on save. Mentions of users without a label are kept as
+// they are, since their names are not known.
+func normalizeSlackText(text string) string {
+	rendered, _ := renderSlackText(text)
+	return rendered
+}
+
+// renderSlackText is normalizeSlackText, but also reports where each entity
+// was rendered, so entities with the same rendering but different targets,
+// such as links labelled alike, can be told apart.
+func renderSlackText(text string) (string, []slackTextSpan) {
+	var rendered strings.Builder
+	var spans []slackTextSpan
+
+	last := 0
+	for _, match := range slackTextEntity.FindAllStringSubmatchIndex(text, -1) {
+		rendered.WriteString(slackTextUnescape.Replace(text[last:match[0]]))
+		last = match[1]
+
+		target := text[match[2]:match[3]]
+		label := ""
+		if match[4] >= 0 {
+			label = slackTextUnescape.Replace(text[match[4]:match[5]])
+		}
+
+		span := slackTextSpan{start: rendered.Len(), target: target}
+
+		if mention, ok := slackTextSpecialMentions[target]; ok {
+			rendered.WriteString(mention)
+			span.typeable = true
+		} else {
+			switch {
+			case label != "" && strings.HasPrefix(target, "#"):
+				rendered.WriteString("#" + strings.TrimPrefix(label, "#"))
+				span.typeable = true
+			case label != "" && (strings.HasPrefix(target, "@") || strings.HasPrefix(target, "!subteam^")):
+				rendered.WriteString("@" + strings.TrimPrefix(label, "@"))
+				span.typeable = true
+			case label != "":
+				rendered.WriteString(label)
+				span.typeable = isSlackLinkRendering(target, label)
+			case strings.HasPrefix(target, "@"), strings.HasPrefix(target, "#"), strings.HasPrefix(target, "!"):
+				rendered.WriteString(text[match[0]:match[1]])
+				span.typeable = true
+			default:
+				rendered.WriteString(slackTextUnescape.Replace(target))
+				span.typeable = true
+			}
+		}
+
+		span.end = rendered.Len()
+		spans = append(spans, span)
+	}
+	rendered.WriteString(slackTextUnescape.Replace(text[last:]))
+
+	return rendered.String(), spans
+}
+
+// isSlackLinkRendering reports whether label is how Slack labels a link to
+// target it linked itself, such as example.com for https://example.com.
+func isSlackLinkRendering(target string, label string) bool {
+	for _, prefix := range []string{"", "mailto:", "https://", "http://"} {
+		if strings.HasPrefix(target, prefix) && strings.TrimPrefix(target, prefix) == label {
+			return true
+		}
+	}
+	return false
+}
+
+// slackTextEqual reports whether a and b only differ in the markup Slack adds
+// on save. Entities rendered in the same place must have the same target, and
+// entities only one of them has must be ones Slack could have added.
+func slackTextEqual(a string, b string) bool {
+	renderedA, spansA := renderSlackText(a)
+	renderedB, spansB := renderSlackText(b)
+
+	if renderedA != renderedB {
+		return false
+	}
+
+	matched := make([]bool, len(spansB))
+
+	for _, spanA := range spansA {
+		i := slices.IndexFunc(spansB, func(spanB slackTextSpan) bool {
+			return spanB.start == spanA.start && spanB.end == spanA.end
+		})
+
+		switch {
+		case i >= 0 && spansB[i].target != spanA.target:
+			return false
+		case i >= 0:
+			matched[i] = true
+		case !spanA.typeable:
+			return false
+		}
+	}
+
+	for i, spanB := range spansB {
+		if !matched[i] && !spanB.typeable {
+			return false
+		}
+	}
+
+	return true
+}
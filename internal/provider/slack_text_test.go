@@ -0,0 +1,75 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+)
+
+func TestNormalizeSlackText(t *testing.T) {
+	tests := map[string]string{
+		"Docs at <https://example.com/docs>":            "Docs at https://example.com/docs",
+		"See <https://example.com|example.com>":         "See example.com",
+		"Mail <mailto:ops@example.com|ops@example.com>": "Mail ops@example.com",
+		"Ask in <#C0123456789|help>":                    "Ask in #help",
+		"Ping <@U0123456789|alice>":                     "Ping @alice",
+		"Ping <@U0123456789>":                           "Ping <@U0123456789>",
+		"Ping <!subteam^S0123456789|@oncall>":           "Ping @oncall",
+		"<!here> deploys & rollbacks &lt;3":             "@here deploys & rollbacks <3",
+		"Tom &amp; Jerry":                               "Tom & Jerry",
+		"Plain topic":                                   "Plain topic",
+	}
+
+	for text, expected := range tests {
+		if normalized := normalizeSlackText(text); normalized != expected {
+			t.Errorf("normalizeSlackText(%q) = %q, expected %q", text, normalized, expected)
+		}
+	}
+}
+
+func TestSlackTextValueSemanticEquals(t *testing.T) {
+	ctx := context.Background()
+
+	configured := NewSlackTextValue("Runbook: https://example.com/runbook & alerts")
+	read := NewSlackTextValue("Runbook: <https://example.com/runbook> &amp; alerts")
+
+	equal, diags := configured.StringSemanticEquals(ctx, read)
+	if diags.HasError() || !equal {
+		t.Fatalf("expected %q and %q to be semantically equal", configured.ValueString(), read.ValueString())
+	}
+
+	equal, diags = configured.StringSemanticEquals(ctx, NewSlackTextValue("Runbook: <https://example.com/other>"))
+	if diags.HasError() || equal {
+		t.Fatalf("expected different links not to be semantically equal")
+	}
+
+	labelled := NewSlackTextValue("<https://example.com/runbook|runbook>")
+	equal, diags = labelled.StringSemanticEquals(ctx, NewSlackTextValue("<https://example.com/other|runbook>"))
+	if diags.HasError() || equal {
+		t.Fatalf("expected links with the same label but different targets not to be semantically equal")
+	}
+}
+
+func TestSlackTextEqual(t *testing.T) {
+	for _, test := range []struct {
+		a, b  string
+		equal bool
+	}{
+		{"Runbook: https://example.com/runbook", "Runbook: <https://example.com/runbook>", true},
+		{"See example.com", "See <https://example.com|example.com>", true},
+		{"Ask in #help", "Ask in <#C0123456789|help>", true},
+		{"Ping @oncall", "Ping <!subteam^S0123456789|@oncall>", true},
+		{"Ask in <#C0123456789|help>", "Ask in <#C0123456789|help>", true},
+		{"<https://old.example.com|runbook>", "<https://new.example.com|runbook>", false},
+		{"Ask in <#C0000000001|help>", "Ask in <#C0000000002|help>", false},
+		{"Ping <@U0000000001|alice>", "Ping <@U0000000002|alice>", false},
+		{"runbook", "<https://example.com|runbook>", false},
+		{"<https://example.com|runbook>", "runbook", false},
+	} {
+		if equal := slackTextEqual(test.a, test.b); equal != test.equal {
+			t.Errorf("slackTextEqual(%q, %q) = %t, expected %t", test.a, test.b, equal, test.equal)
+		}
+	}
+}
@@ -0,0 +1,190 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral"
+	"github.com/hashicorp/terraform-plugin-framework/ephemeral/schema"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ ephemeral.EphemeralResource = &TokenEphemeralResource{}
+var _ ephemeral.EphemeralResourceWithConfigure = &TokenEphemeralResource{}
+
+func NewTokenEphemeralResource() ephemeral.EphemeralResource {
+	return &TokenEphemeralResource{}
+}
+
+// TokenEphemeralResource defines the ephemeral resource implementation.
+type TokenEphemeralResource struct {
+	client *SlackClient
+}
+
+// TokenEphemeralResourceModel describes the ephemeral resource data model.
+type TokenEphemeralResourceModel struct {
+	RefreshToken     types.String `tfsdk:"refresh_token"`
+	ClientId         types.String `tfsdk:"client_id"`
+	ClientSecret     types.String `tfsdk:"client_secret"`
+	Token            types.String `tfsdk:"token"`
+	NextRefreshToken types.String `tfsdk:"next_refresh_token"`
+	ExpiresAt        types.Int64  `tfsdk:"expires_at"`
+	TeamId           types.String `tfsdk:"team_id"`
+}
+
+func (r *TokenEphemeralResource) Metadata(ctx context.Context, req ephemeral.MetadataRequest, resp *ephemeral.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token"
+}
+
+func (r *TokenEphemeralResource) Schema(ctx context.Context, req ephemeral.SchemaRequest, resp *ephemeral.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Exchanges a refresh token for a short-lived Slack token, without storing either in state.
+
+With ` + "`client_id`" + ` set, an app access token is refreshed through ` + "`oauth.v2.access`" + `, for apps with token rotation enabled.
+Otherwise an app configuration token, as used by the ` + "`apps.manifest.*`" + ` methods, is rotated through ` + "`tooling.tokens.rotate`" + `.
+
+~> Terraform opens ephemeral resources during both plan and apply, so each run makes more than one exchange. Every exchange returns a new refresh token in ` + "`next_refresh_token`" + `, which should be used in place of the old one once Slack has rotated it.
+`,
+		Attributes: map[string]schema.Attribute{
+			"refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token to exchange.",
+				Required:            true,
+				Sensitive:           true,
+			},
+			"client_id": schema.StringAttribute{
+				MarkdownDescription: "Client ID of the app whose access token is refreshed. Leave unset to rotate an app configuration token instead.",
+				Optional:            true,
+			},
+			"client_secret": schema.StringAttribute{
+				MarkdownDescription: "Client secret of the app. Not needed by apps using PKCE.",
+				Optional:            true,
+				Sensitive:           true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("client_id")),
+				},
+			},
+			"token": schema.StringAttribute{
+				MarkdownDescription: "The short-lived token.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"next_refresh_token": schema.StringAttribute{
+				MarkdownDescription: "The refresh token to use for the next exchange.",
+				Computed:            true,
+				Sensitive:           true,
+			},
+			"expires_at": schema.Int64Attribute{
+				MarkdownDescription: "When `token` expires, as a Unix timestamp.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace the token belongs to.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (r *TokenEphemeralResource) Configure(ctx context.Context, req ephemeral.ConfigureRequest, resp *ephemeral.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Ephemeral Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *TokenEphemeralResource) Open(ctx context.Context, req ephemeral.OpenRequest, resp *ephemeral.OpenResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data TokenEphemeralResourceModel
+	client := r.client
+
+	// Read Terraform config data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !data.ClientId.IsNull() {
+		var response *slack.OAuthV2Response
+
+		err := client.retry(ctx, "oauth.v2.access", func() (err error) {
+			response, err = client.refreshOAuthV2Token(
+				ctx, data.ClientId.ValueString(), data.ClientSecret.ValueString(), data.RefreshToken.ValueString(),
+			)
+			return err
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to refresh access token, got error: %s", err))
+			return
+		}
+
+		data.Token = types.StringValue(response.AccessToken)
+		data.NextRefreshToken = types.StringValue(response.RefreshToken)
+		data.ExpiresAt = types.Int64Value(time.Now().Unix() + int64(response.ExpiresIn))
+		data.TeamId = types.StringValue(response.Team.ID)
+	} else {
+		var response *slack.TokenResponse
+
+		err := client.retry(ctx, "tooling.tokens.rotate", func() (err error) {
+			response, err = client.RotateTokensContext(ctx, "", data.RefreshToken.ValueString())
+			return err
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to rotate configuration token, got error: %s", err))
+			return
+		}
+
+		data.Token = types.StringValue(response.Token)
+		data.NextRefreshToken = types.StringValue(response.RefreshToken)
+		data.ExpiresAt = types.Int64Value(int64(response.ExpiresAt))
+		data.TeamId = types.StringValue(response.TeamId)
+	}
+
+	resp.Diagnostics.Append(resp.Result.Set(ctx, &data)...)
+}
+
+// refreshOAuthV2Token exchanges an app's refresh token through oauth.v2.access,
+// which is not a method of the Slack API client.
+func (c *SlackClient) refreshOAuthV2Token(ctx context.Context, clientId string, clientSecret string, refreshToken string) (*slack.OAuthV2Response, error) {
+	httpClient := c.httpClient
+	if httpClient == nil {
+		httpClient = http.DefaultClient
+	}
+
+	var options []slack.OAuthOption
+	if c.apiURL != "" {
+		options = append(options, slack.OAuthOptionAPIURL(c.apiURL))
+	}
+
+	return slack.RefreshOAuthV2TokenContext(ctx, httpClient, clientId, clientSecret, refreshToken, options...)
+}
@@ -0,0 +1,93 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-testing/echoprovider"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+// testAccEchoProviders adds the echo provider, which copies ephemeral values
+// into state so tests can check them, to the provider factories for t.
+func testAccEchoProviders(t *testing.T) map[string]func() (tfprotov6.ProviderServer, error) {
+	t.Helper()
+
+	factories := maps.Clone(testAccProviders(t))
+	factories["echo"] = echoprovider.NewProviderServer()
+	return factories
+}
+
+func TestAccTokenEphemeralResourceConfigToken(t *testing.T) {
+	refreshToken := testAccFixture(t, testEnvConfigRefreshToken)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccEchoProviders(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources require Terraform 1.10.0 or later.
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "slack_token" "test" {
+  refresh_token = "` + refreshToken + `"
+}
+
+provider "echo" {
+  data = ephemeral.slack_token.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("next_refresh_token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("team_id"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
+
+func TestAccTokenEphemeralResourceAccessToken(t *testing.T) {
+	clientId := testAccFixture(t, testEnvClientId)
+	refreshToken := testAccFixture(t, testEnvRefreshToken)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccEchoProviders(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// Ephemeral resources require Terraform 1.10.0 or later.
+			tfversion.SkipBelow(tfversion.Version1_10_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+ephemeral "slack_token" "test" {
+  client_id     = "` + clientId + `"
+  refresh_token = "` + refreshToken + `"
+}
+
+provider "echo" {
+  data = ephemeral.slack_token.test
+}
+
+resource "echo" "test" {}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("token"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("expires_at"), knownvalue.NotNull()),
+					statecheck.ExpectKnownValue("echo.test", tfjsonpath.New("data").AtMapKey("team_id"), knownvalue.NotNull()),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,117 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &TokenScopesDataSource{}
+	_ datasource.DataSourceWithConfigure = &TokenScopesDataSource{}
+)
+
+func NewTokenScopesDataSource() datasource.DataSource {
+	return &TokenScopesDataSource{}
+}
+
+// TokenScopesDataSource defines the data source implementation.
+type TokenScopesDataSource struct {
+	client *SlackClient
+}
+
+// TokenScopesDataSourceModel describes the data source data model.
+type TokenScopesDataSourceModel struct {
+	Id     types.String `tfsdk:"id"`
+	TeamId types.String `tfsdk:"team_id"`
+	UserId types.String `tfsdk:"user_id"`
+	Scopes types.List   `tfsdk:"scopes"`
+}
+
+func (d *TokenScopesDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_token_scopes"
+}
+
+func (d *TokenScopesDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+The OAuth scopes granted to the provider's token, as reported by ` + "`auth.test`" + ` when the provider is configured.
+Use it to check for the scopes a module needs in a precondition, before any change is attempted.
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the token's identity, in the form `team_id:user_id`.",
+				Computed:            true,
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the token belongs to.",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user, or bot user, the token acts as.",
+				Computed:            true,
+			},
+			"scopes": schema.ListAttribute{
+				MarkdownDescription: "The scopes granted to the token, sorted. Empty for tokens Slack reports no scopes for, such as legacy tokens.",
+				ElementType:         types.StringType,
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *TokenScopesDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *TokenScopesDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var data TokenScopesDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// The scopes were captured when the provider called auth.test, so no
+	// further API call is needed.
+	scopes, diags := types.ListValueFrom(ctx, types.StringType, slices.Sorted(slices.Values(d.client.scopes)))
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	data.Id = types.StringValue(d.client.teamId + ":" + d.client.userId)
+	data.TeamId = types.StringValue(d.client.teamId)
+	data.UserId = types.StringValue(d.client.userId)
+	data.Scopes = scopes
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,29 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccTokenScopesDataSource(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_token_scopes" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_token_scopes.test", "team_id"),
+					resource.TestCheckResourceAttrSet("data.slack_token_scopes.test", "user_id"),
+					resource.TestCheckTypeSetElemAttr("data.slack_token_scopes.test", "scopes.*", "users:read"),
+				),
+			},
+		},
+	})
+}
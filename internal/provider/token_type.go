@@ -0,0 +1,84 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+)
+
+// The kinds of Slack tokens, told apart by their prefix.
+const (
+	tokenTypeBot     = "bot"
+	tokenTypeUser    = "user"
+	tokenTypeApp     = "app"
+	tokenTypeRefresh = "refresh"
+	tokenTypeUnknown = "unknown"
+)
+
+// detectTokenType returns the kind of the given Slack token. Rotating tokens
+// (xoxe.xoxb- and xoxe.xoxp-) are of the kind they rotate, while a bare xoxe-
+// token is the refresh token used to rotate them.
+func detectTokenType(token string) string {
+	token = strings.TrimPrefix(token, "xoxe.")
+
+	switch {
+	case strings.HasPrefix(token, "xoxb-"):
+		return tokenTypeBot
+	case strings.HasPrefix(token, "xoxp-"):
+		return tokenTypeUser
+	case strings.HasPrefix(token, "xapp-"):
+		return tokenTypeApp
+	case strings.HasPrefix(token, "xoxe-"):
+		return tokenTypeRefresh
+	default:
+		return tokenTypeUnknown
+	}
+}
+
+// validateProviderToken fails for the kinds of tokens that cannot call the
+// Slack Web API methods the provider uses at all.
+func validateProviderToken(tokenType string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	switch tokenType {
+	case tokenTypeApp:
+		diags.AddError(
+			"Unsupported Slack Token",
+			"The provider is configured with an app-level token (xapp-), which can only open Socket Mode connections. "+
+				"Configure it with the bot token (xoxb-) or user token (xoxp-) of an app installed to the workspace instead.",
+		)
+	case tokenTypeRefresh:
+		diags.AddError(
+			"Unsupported Slack Token",
+			"The provider is configured with a refresh token (xoxe-), which can only be exchanged for an access token. "+
+				"Exchange it with the slack_token ephemeral resource, or with tooling.tokens.rotate, and configure the provider "+
+				"with the resulting token instead.",
+		)
+	}
+
+	return diags
+}
+
+// requireUserToken fails when the provider is configured with a bot token,
+// for resources and data sources built on Slack's admin API, which only
+// accepts the user tokens of admins. Tokens of unknown kinds are let through
+// for Slack to judge.
+func (c *SlackClient) requireUserToken(typeName string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if c.tokenType == tokenTypeBot {
+		diags.AddError(
+			"Unsupported Slack Token",
+			fmt.Sprintf("%s uses Slack's admin API, which needs the user token (xoxp-) of a workspace or organization admin, "+
+				"but the provider is configured with a bot token (xoxb-). On Enterprise Grid, install the app at the organization "+
+				"level and configure the provider with the admin's user token, with a provider alias if a bot token is still "+
+				"needed for other resources.", typeName),
+		)
+	}
+
+	return diags
+}
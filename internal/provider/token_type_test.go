@@ -0,0 +1,52 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+)
+
+func TestDetectTokenType(t *testing.T) {
+	for token, expected := range map[string]string{
+		"xoxb-1-abc":        tokenTypeBot,
+		"xoxp-1-abc":        tokenTypeUser,
+		"xoxe.xoxb-1-abc":   tokenTypeBot,
+		"xoxe.xoxp-1-abc":   tokenTypeUser,
+		"xapp-1-abc":        tokenTypeApp,
+		"xoxe-1-abc":        tokenTypeRefresh,
+		"":                  tokenTypeUnknown,
+		"legacy-test-token": tokenTypeUnknown,
+	} {
+		if tokenType := detectTokenType(token); tokenType != expected {
+			t.Errorf("expected %q to be a %s token, got: %s", token, expected, tokenType)
+		}
+	}
+}
+
+func TestValidateProviderToken(t *testing.T) {
+	for _, tokenType := range []string{tokenTypeApp, tokenTypeRefresh} {
+		if !validateProviderToken(tokenType).HasError() {
+			t.Errorf("expected %s token to be rejected", tokenType)
+		}
+	}
+	for _, tokenType := range []string{tokenTypeBot, tokenTypeUser, tokenTypeUnknown} {
+		if diags := validateProviderToken(tokenType); diags.HasError() {
+			t.Errorf("expected %s token to be accepted, got: %v", tokenType, diags)
+		}
+	}
+}
+
+func TestRequireUserToken(t *testing.T) {
+	client := &SlackClient{tokenType: tokenTypeBot}
+	if !client.requireUserToken("slack_admin_users").HasError() {
+		t.Error("expected bot token to be rejected")
+	}
+
+	for _, tokenType := range []string{tokenTypeUser, tokenTypeUnknown} {
+		client := &SlackClient{tokenType: tokenType}
+		if diags := client.requireUserToken("slack_admin_users"); diags.HasError() {
+			t.Errorf("expected %s token to be accepted, got: %v", tokenType, diags)
+		}
+	}
+}
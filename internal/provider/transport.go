@@ -0,0 +1,100 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"net/http"
+)
+
+// transportOptions configure the HTTP transport used to reach the Slack API.
+type transportOptions struct {
+	// insecureSkipVerify disables verification of the Slack API's TLS
+	// certificate.
+	insecureSkipVerify bool
+
+	// caCertPEM holds PEM encoded certificates trusted in addition to the
+	// system's, such as that of a TLS-intercepting proxy.
+	caCertPEM string
+}
+
+// maxIdleConnsPerHost is how many idle connections to the Slack API are kept
+// open for reuse. Every request goes to the same host, and Terraform makes up
+// to 10 in parallel by default, so http.DefaultTransport's 2 would have most
+// requests of large applies set up a new connection.
+const maxIdleConnsPerHost = 32
+
+// newTransport returns a transport based on http.DefaultTransport with the
+// given options applied.
+func newTransport(options transportOptions) (*http.Transport, error) {
+	defaultTransport, ok := http.DefaultTransport.(*http.Transport)
+	if !ok {
+		return nil, fmt.Errorf("unexpected default transport: %T", http.DefaultTransport)
+	}
+	transport := defaultTransport.Clone()
+	transport.MaxIdleConnsPerHost = maxIdleConnsPerHost
+
+	if !options.insecureSkipVerify && options.caCertPEM == "" {
+		return transport, nil
+	}
+
+	tlsConfig := &tls.Config{
+		MinVersion:         tls.VersionTLS12,
+		InsecureSkipVerify: options.insecureSkipVerify,
+	}
+
+	if options.caCertPEM != "" {
+		pool, err := x509.SystemCertPool()
+		if err != nil {
+			pool = x509.NewCertPool()
+		}
+		if !pool.AppendCertsFromPEM([]byte(options.caCertPEM)) {
+			return nil, fmt.Errorf("no PEM encoded certificates found")
+		}
+		tlsConfig.RootCAs = pool
+	}
+
+	transport.TLSClientConfig = tlsConfig
+
+	return transport, nil
+}
+
+// userAgentTransport sets the User-Agent header of every request, so Slack's
+// request logs and egress proxies can attribute traffic to the provider.
+type userAgentTransport struct {
+	userAgent string
+	next      http.RoundTripper
+}
+
+func (t *userAgentTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	req.Header.Set("User-Agent", t.userAgent)
+	return t.next.RoundTrip(req)
+}
+
+// headersTransport sets the configured extra headers on every request.
+type headersTransport struct {
+	headers map[string]string
+	next    http.RoundTripper
+}
+
+func (t *headersTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	req = req.Clone(req.Context())
+	for name, value := range t.headers {
+		req.Header.Set(name, value)
+	}
+	return t.next.RoundTrip(req)
+}
+
+// userAgent returns the User-Agent the provider identifies itself with,
+// followed by the user-supplied suffix, if any.
+func userAgent(providerVersion string, terraformVersion string, suffix string) string {
+	userAgent := fmt.Sprintf("terraform-provider-slack/%s (+https://registry.terraform.io/providers/mw-root/slack) Terraform/%s", providerVersion, terraformVersion)
+	if suffix != "" {
+		userAgent += " " + suffix
+	}
+	return userAgent
+}
@@ -0,0 +1,97 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"encoding/pem"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+)
+
+func TestNewTransport(t *testing.T) {
+	server := httptest.NewTLSServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {}))
+	defer server.Close()
+
+	caCertPEM := string(pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: server.Certificate().Raw}))
+
+	for name, test := range map[string]struct {
+		options   transportOptions
+		expectErr bool
+	}{
+		"default":              {options: transportOptions{}, expectErr: true},
+		"insecure_skip_verify": {options: transportOptions{insecureSkipVerify: true}},
+		"ca_cert_pem":          {options: transportOptions{caCertPEM: caCertPEM}},
+	} {
+		t.Run(name, func(t *testing.T) {
+			transport, err := newTransport(test.options)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if transport.MaxIdleConnsPerHost != maxIdleConnsPerHost {
+				t.Fatalf("expected %d idle connections to be kept, got: %d", maxIdleConnsPerHost, transport.MaxIdleConnsPerHost)
+			}
+
+			resp, err := (&http.Client{Transport: transport}).Get(server.URL)
+			if err == nil {
+				resp.Body.Close()
+			}
+			if test.expectErr != (err != nil) {
+				t.Fatalf("expected error: %t, got: %v", test.expectErr, err)
+			}
+		})
+	}
+}
+
+func TestNewTransportInvalidCACertPEM(t *testing.T) {
+	if _, err := newTransport(transportOptions{caCertPEM: "not a certificate"}); err == nil {
+		t.Fatal("expected an error for invalid CA certificates")
+	}
+}
+
+func TestUserAgentTransport(t *testing.T) {
+	var received string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header.Get("User-Agent")
+	}))
+	defer server.Close()
+
+	expected := "terraform-provider-slack/1.2.3 (+https://registry.terraform.io/providers/mw-root/slack) Terraform/1.9.0 platform-team"
+	if agent := userAgent("1.2.3", "1.9.0", "platform-team"); agent != expected {
+		t.Fatalf("expected User-Agent %q, got: %q", expected, agent)
+	}
+
+	client := &http.Client{Transport: &userAgentTransport{userAgent: expected, next: http.DefaultTransport}}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if received != expected {
+		t.Fatalf("expected User-Agent %q to be sent, got: %q", expected, received)
+	}
+}
+
+func TestHeadersTransport(t *testing.T) {
+	var received http.Header
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		received = r.Header
+	}))
+	defer server.Close()
+
+	client := &http.Client{Transport: &headersTransport{
+		headers: map[string]string{"X-Egress-Auth": "secret", "x-trace-id": "abc"},
+		next:    http.DefaultTransport,
+	}}
+	resp, err := client.Get(server.URL)
+	if err != nil {
+		t.Fatal(err)
+	}
+	resp.Body.Close()
+
+	if received.Get("X-Egress-Auth") != "secret" || received.Get("X-Trace-Id") != "abc" {
+		t.Fatalf("expected the configured headers to be sent, got: %v", received)
+	}
+}
@@ -0,0 +1,40 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+)
+
+// unsetEmptyStringsUpgrader returns a StateUpgrader from a schema version in
+// which the given attributes were Computed with a default of "". Those are
+// made null in the upgraded state, as this provider does not manage
+// attributes that are null, so existing state does not plan "" -> null.
+// priorSchema must have the same attribute types as the current schema.
+func unsetEmptyStringsUpgrader(priorSchema schema.Schema, attributes ...string) resource.StateUpgrader {
+	return resource.StateUpgrader{
+		PriorSchema: &priorSchema,
+		StateUpgrader: func(ctx context.Context, req resource.UpgradeStateRequest, resp *resource.UpgradeStateResponse) {
+			resp.State.Raw = req.State.Raw
+
+			for _, attribute := range attributes {
+				var value *string
+
+				resp.Diagnostics.Append(resp.State.GetAttribute(ctx, path.Root(attribute), &value)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				if value != nil && *value == "" {
+					resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(attribute), (*string)(nil))...)
+				}
+			}
+		},
+	}
+}
@@ -0,0 +1,89 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+// upgradeState upgrades the given raw state of a resource of type typeName
+// from the given schema version, as Terraform does when planning with state
+// written by an earlier version of this provider, and returns its attributes.
+func upgradeState(t *testing.T, typeName string, version int64, rawState string) map[string]tftypes.Value {
+	t.Helper()
+	ctx := context.Background()
+
+	server := providerserver.NewProtocol6(New("test")())()
+
+	schemaResp, err := server.GetProviderSchema(ctx, &tfprotov6.GetProviderSchemaRequest{})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	objectType := schemaResp.ResourceSchemas[typeName].ValueType()
+
+	resp, err := server.UpgradeResourceState(ctx, &tfprotov6.UpgradeResourceStateRequest{
+		TypeName: typeName,
+		Version:  version,
+		RawState: &tfprotov6.RawState{JSON: []byte(rawState)},
+	})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	for _, d := range resp.Diagnostics {
+		t.Fatalf("unexpected diagnostic: %s: %s", d.Summary, d.Detail)
+	}
+
+	state, err := resp.UpgradedState.Unmarshal(objectType)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	var attributes map[string]tftypes.Value
+	if err := state.As(&attributes); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	return attributes
+}
+
+func TestChannelResourceUpgradeState(t *testing.T) {
+	attributes := upgradeState(t, "slack_channel", 0, `{
+		"id": "C0123456789",
+		"name": "general-chat",
+		"topic": "",
+		"description": "Anything goes",
+		"is_private": false,
+		"action_on_destroy": "archive"
+	}`)
+
+	if !attributes["topic"].IsNull() {
+		t.Errorf("expected an empty topic to become unmanaged, got: %s", attributes["topic"])
+	}
+	if !attributes["description"].Equal(tftypes.NewValue(tftypes.String, "Anything goes")) {
+		t.Errorf("expected the description to be kept, got: %s", attributes["description"])
+	}
+	if !attributes["name"].Equal(tftypes.NewValue(tftypes.String, "general-chat")) {
+		t.Errorf("expected the name to be kept, got: %s", attributes["name"])
+	}
+}
+
+func TestUserGroupResourceUpgradeState(t *testing.T) {
+	attributes := upgradeState(t, "slack_usergroup", 0, `{
+		"id": "S0123456789",
+		"name": "Marketing",
+		"handle": "marketing",
+		"description": ""
+	}`)
+
+	if !attributes["description"].IsNull() {
+		t.Errorf("expected an empty description to become unmanaged, got: %s", attributes["description"])
+	}
+	if !attributes["handle"].Equal(tftypes.NewValue(tftypes.String, "marketing")) {
+		t.Errorf("expected the handle to be kept, got: %s", attributes["handle"])
+	}
+}
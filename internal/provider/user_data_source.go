@@ -5,8 +5,9 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"fmt"
-	"time"
+	"slices"
 
 	"github.com/slack-go/slack"
 
@@ -30,7 +31,7 @@ func NewUserDataSource() datasource.DataSource {
 
 // UserDataSource defines the data source implementation.
 type UserDataSource struct {
-	client *slack.Client
+	client *SlackClient
 }
 
 // UserDataSourceModel describes the data source data model.
@@ -44,6 +45,34 @@ type UserDataSourceModel struct {
 	TimeZone           types.String `tfsdk:"time_zone"`
 	IsAdmin            types.Bool   `tfsdk:"is_admin"`
 	IsBot              types.Bool   `tfsdk:"is_bot"`
+	BotId              types.String `tfsdk:"bot_id"`
+	FailIfNotFound     types.Bool   `tfsdk:"fail_if_not_found"`
+	Found              types.Bool   `tfsdk:"found"`
+}
+
+// emailValue returns email as the value of an email attribute read from
+// Slack, or null when the provider is configured to redact emails.
+func (c *SlackClient) emailValue(email string) types.String {
+	if c.redactEmails {
+		return types.StringNull()
+	}
+	return types.StringValue(email)
+}
+
+// errUserNotFound is returned when looking up a user by name or email finds
+// no user.
+var errUserNotFound = errors.New("not found")
+
+// isUserNotFound reports whether err means the user looked up does not exist.
+func isUserNotFound(err error) bool {
+	if errors.Is(err, errUserNotFound) {
+		return true
+	}
+	switch err.Error() {
+	case "user_not_found", "users_not_found":
+		return true
+	}
+	return false
 }
 
 func (d *UserDataSource) ConfigValidators(ctx context.Context) []datasource.ConfigValidator {
@@ -52,6 +81,7 @@ func (d *UserDataSource) ConfigValidators(ctx context.Context) []datasource.Conf
 			path.MatchRoot("id"),
 			path.MatchRoot("name"),
 			path.MatchRoot("email"),
+			path.MatchRoot("bot_id"),
 		),
 	}
 }
@@ -64,7 +94,7 @@ func (d *UserDataSource) Schema(ctx context.Context, req datasource.SchemaReques
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: `
-Reads a slack user specified by name or id, and returns attributes.
+Reads a slack user specified by name, id, email or bot ID, and returns attributes.
 ### Required Permissions
 - ` + "`users:read`" + `
 - ` + "`users:read.email`" + ` (Only if ` + "`email`" + ` is used as an input)
@@ -109,6 +139,21 @@ Reads a slack user specified by name or id, and returns attributes.
 				MarkdownDescription: "Indicates whether the user is actually a bot user. Bleep bloop. Note that Slackbot is special, so `is_bot` will be false for it.",
 				Computed:            true,
 			},
+			"bot_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the bot, starting with `B`, whose bot user to read, as found in the payloads of integrations. " +
+					"Only set for bot users otherwise.",
+				Optional: true,
+				Computed: true,
+			},
+			"fail_if_not_found": schema.BoolAttribute{
+				MarkdownDescription: "Set false to set `found` to false and leave the user's attributes null when they do not exist, " +
+					"rather than failing. Defaults to true.",
+				Optional: true,
+			},
+			"found": schema.BoolAttribute{
+				MarkdownDescription: "Indicates whether the user was found. Only false when `fail_if_not_found` is false.",
+				Computed:            true,
+			},
 		},
 	}
 }
@@ -119,12 +164,12 @@ func (d *UserDataSource) Configure(ctx context.Context, req datasource.Configure
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*SlackClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *slack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -134,6 +179,9 @@ func (d *UserDataSource) Configure(ctx context.Context, req datasource.Configure
 }
 
 func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data UserDataSourceModel
 	var user *slack.User
 	var err error
@@ -147,29 +195,56 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 
 	switch {
 	case !data.Id.IsNull():
-		user, err = d.client.GetUserInfoContext(ctx, data.Id.ValueString())
+		err = d.client.retry(ctx, "users.info", func() (err error) {
+			user, err = d.client.GetUserInfoContext(ctx, data.Id.ValueString())
+			return err
+		})
 
 	case !data.Email.IsNull():
 		user, err = getUserByEmail(ctx, d.client, data.Email.ValueString(), data.IncludeDeactivated.ValueBool())
 
+	case !data.BotId.IsNull():
+		user, err = getUserByBotId(ctx, d.client, data.BotId.ValueString())
+
 	default:
 		user, err = getUserByName(ctx, d.client, data.Name.ValueString())
 	}
 
+	if err != nil && isUserNotFound(err) && !data.FailIfNotFound.IsNull() && !data.FailIfNotFound.ValueBool() {
+		tflog.Trace(ctx, "User not found, leaving attributes null", map[string]any{
+			"user_id":   data.Id.ValueString(),
+			"user_name": data.Name.ValueString(),
+		})
+
+		data.Found = types.BoolValue(false)
+		data.RealName = types.StringNull()
+		data.Deleted = types.BoolNull()
+		data.TimeZone = types.StringNull()
+		data.IsAdmin = types.BoolNull()
+		data.IsBot = types.BoolNull()
+
+		resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+		return
+	}
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find user, got error: %s", err))
 		return
 	}
 
 	// Set data from API response.
+	data.Found = types.BoolValue(true)
 	data.Id = types.StringValue(user.ID)
 	data.Name = types.StringValue(user.Name)
-	data.Email = types.StringValue(user.Profile.Email)
+	if data.Email.IsNull() {
+		data.Email = d.client.emailValue(user.Profile.Email)
+	}
 	data.RealName = types.StringValue(user.RealName)
 	data.Deleted = types.BoolValue(user.Deleted)
 	data.TimeZone = types.StringValue(user.TZ)
 	data.IsAdmin = types.BoolValue(user.IsAdmin)
 	data.IsBot = types.BoolValue(user.IsBot)
+	data.BotId = optionalString(user.Profile.BotID)
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
@@ -178,61 +253,104 @@ func (d *UserDataSource) Read(ctx context.Context, req datasource.ReadRequest, r
 // This is basically the logic in slack.GetUsersContext.
 // This is exploded here instead of using that method to ensure we're checking
 // each returned page, potentially saving some API calls.
-func getUserByName(ctx context.Context, client *slack.Client, name string) (*slack.User, error) {
-
-	tflog.Trace(ctx, "Requesting Page of Slack Users")
+func getUserByName(ctx context.Context, client *SlackClient, name string) (*slack.User, error) {
 
-	var err interface{}
-	err = nil
+	// Every user needs to be listed to be cached, so pages are only checked
+	// as they come in without a cache.
+	if client.cache != nil {
+		users, err := listUsers(ctx, client, func(user slack.User) bool { return user.Name == name })
+		if err != nil {
+			return &slack.User{}, err
+		}
+		for _, user := range users {
+			if user.Name == name {
+				return &user, nil
+			}
+		}
+		return &slack.User{}, fmt.Errorf("user: %s %w", name, errUserNotFound)
+	}
 
 	page := client.GetUsersPaginated()
 
-	for _, user := range page.Users {
-		if user.Name == name {
-			return &user, nil
+	for {
+		tflog.Trace(ctx, "Requesting page of Slack users", map[string]any{"user_name": name})
+
+		err := client.retry(ctx, "users.list", func() (err error) {
+			page, err = page.Next(ctx)
+			return err
+		})
+
+		if page.Done(err) {
+			break
+		}
+		if err != nil {
+			return &slack.User{}, err
 		}
-	}
 
-	for err == nil {
-		page, err = page.Next(ctx)
-		if err == nil {
-			for _, user := range page.Users {
-				if user.Name == name {
-					return &user, nil
-				}
-			}
-		} else if rateLimitedError, ok := err.(*slack.RateLimitedError); ok {
-			select {
-			case <-ctx.Done():
-				err = ctx.Err()
-			case <-time.After(rateLimitedError.RetryAfter):
-				err = nil
+		for _, user := range page.Users {
+			if user.Name == name {
+				return &user, nil
 			}
 		}
 	}
 
-	return &slack.User{}, fmt.Errorf("user: %s not found", name)
+	return &slack.User{}, fmt.Errorf("user: %s %w", name, errUserNotFound)
 
 }
 
-func getUserByEmail(ctx context.Context, client *slack.Client, email string, includeDeactivated bool) (*slack.User, error) {
+// getUserByBotId finds the bot user of a bot with bots.info. Legacy bots
+// without a bot user are not found.
+func getUserByBotId(ctx context.Context, client *SlackClient, botId string) (*slack.User, error) {
+	var bot *slack.Bot
 
-	tflog.Trace(ctx, "Requesting Page of Slack Users")
+	err := client.retry(ctx, "bots.info", func() (err error) {
+		bot, err = client.GetBotInfoContext(ctx, slack.GetBotInfoParameters{Bot: botId})
+		return err
+	})
+
+	if err != nil {
+		if err.Error() == "bot_not_found" {
+			return &slack.User{}, fmt.Errorf("bot: %s %w", botId, errUserNotFound)
+		}
+		return &slack.User{}, err
+	}
+	if bot.UserID == "" {
+		return &slack.User{}, fmt.Errorf("bot user of bot: %s %w", botId, errUserNotFound)
+	}
+
+	var user *slack.User
+
+	err = client.retry(ctx, "users.info", func() (err error) {
+		user, err = client.GetUserInfoContext(ctx, bot.UserID)
+		return err
+	})
+
+	return user, err
+}
 
-	user, err := client.GetUserByEmailContext(ctx, email)
+func getUserByEmail(ctx context.Context, client *SlackClient, email string, includeDeactivated bool) (*slack.User, error) {
+
+	tflog.Trace(ctx, "Looking up Slack user by email", map[string]any{"include_deactivated": includeDeactivated})
+
+	var user *slack.User
+
+	err := client.retry(ctx, "users.lookupByEmail", func() (err error) {
+		user, err = client.GetUserByEmailContext(ctx, email)
+		return err
+	})
 
 	if err == nil {
 		return user, nil
 	} else {
 		if err.Error() == "users_not_found" && includeDeactivated {
-			tflog.Trace(ctx, "User not found in active users.")
+			tflog.Trace(ctx, "User not found in active users")
 		} else {
 			return &slack.User{}, err
 		}
 	}
-	tflog.Trace(ctx, "Searching inactive users.")
+	tflog.Trace(ctx, "Searching inactive users")
 
-	users, err := client.GetUsersContext(ctx)
+	users, err := listUsers(ctx, client, func(user slack.User) bool { return user.Profile.Email == email })
 
 	if err != nil {
 		return &slack.User{}, err
@@ -244,5 +362,35 @@ func getUserByEmail(ctx context.Context, client *slack.Client, email string, inc
 		}
 	}
 
-	return &slack.User{}, fmt.Errorf("user: %s not found", email)
+	return &slack.User{}, fmt.Errorf("user: %s %w", email, errUserNotFound)
+}
+
+// listUsers lists every user, using the cached users if one of them matches.
+// With redact_emails set, emails are left out of the cached users, so they
+// never reach the disk.
+func listUsers(ctx context.Context, client *SlackClient, match func(slack.User) bool) ([]slack.User, error) {
+	var users []slack.User
+	if client.cache.get(ctx, "users.list", &users) && slices.ContainsFunc(users, match) {
+		return users, nil
+	}
+
+	err := client.retry(ctx, "users.list", func() (err error) {
+		users, err = client.GetUsersContext(ctx)
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	cached := users
+	if client.redactEmails {
+		cached = make([]slack.User, len(users))
+		for i, user := range users {
+			user.Profile.Email = ""
+			cached[i] = user
+		}
+	}
+	client.cache.put(ctx, "users.list", cached)
+
+	return users, nil
 }
@@ -10,19 +10,20 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
-const testUserName = "mike.weeks"
-const testUserId = "U06F3KHU2J2"
-
 func TestAccUserDataSource(t *testing.T) {
+	userName := testAccFixture(t, testEnvUserName)
+	userId := testAccFixture(t, testEnvUserId)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviders(t),
 		Steps: []resource.TestStep{
 			{
-				Config: providerConfig + testAccUserDataSourceConfig,
+				Config: providerConfig + testAccUserDataSourceConfig(userName, userId),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.slack_user.test_by_name", "id", testUserId),
-					resource.TestCheckResourceAttr("data.slack_user.test_by_id", "name", testUserName),
+					resource.TestCheckResourceAttr("data.slack_user.test_by_name", "id", userId),
+					resource.TestCheckResourceAttr("data.slack_user.test_by_name", "found", "true"),
+					resource.TestCheckResourceAttr("data.slack_user.test_by_id", "name", userName),
 				),
 			},
 			{
@@ -39,18 +40,55 @@ func TestAccUserDataSource(t *testing.T) {
 				),
 				ExpectError: regexp.MustCompile(`Unable to find user`),
 			},
+			{
+				Config: providerConfig + testAccUserNotFoundDataSourceConfig,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_user.not_found_by_email", "found", "false"),
+					resource.TestCheckNoResourceAttr("data.slack_user.not_found_by_email", "id"),
+					resource.TestCheckResourceAttr("data.slack_user.not_found_by_id", "found", "false"),
+					resource.TestCheckNoResourceAttr("data.slack_user.not_found_by_id", "name"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccUserDataSourceRedactEmails(t *testing.T) {
+	userId := testAccFixture(t, testEnvUserId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "slack" {
+  redact_emails = true
+}
+
+data "slack_user" "test" {
+  id = "` + userId + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_user.test", "id", userId),
+					resource.TestCheckNoResourceAttr("data.slack_user.test", "email"),
+				),
+			},
 		},
 	})
 }
 
-const testAccUserDataSourceConfig = `
+func testAccUserDataSourceConfig(name string, id string) string {
+	return `
 data "slack_user" "test_by_name" {
-  name = "` + testUserName + `"
+  name = "` + name + `"
 }
 data "slack_user" "test_by_id" {
-  id = "` + testUserId + `"
+  id = "` + id + `"
 }
 `
+}
 
 const testAccUserNameDoesNotExistDataSourceConfig = `
 data "slack_user" "does_not_exist" {
@@ -64,3 +102,43 @@ data "slack_user" "does_not_exist" {
   include_deactivated = true
 }
 `
+
+const testAccUserNotFoundDataSourceConfig = `
+data "slack_user" "not_found_by_email" {
+  email             = "dne@example.com"
+  fail_if_not_found = false
+}
+data "slack_user" "not_found_by_id" {
+  id                = "UDOESNOTEXIST"
+  fail_if_not_found = false
+}
+`
+
+func TestAccUserDataSourceByBotId(t *testing.T) {
+	botId := testAccFixture(t, testEnvBotId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_user" "test" {
+  bot_id = "` + botId + `"
+}
+
+data "slack_user" "not_found" {
+  bot_id            = "B0DOESNOTEXIST"
+  fail_if_not_found = false
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_user.test", "id"),
+					resource.TestCheckResourceAttr("data.slack_user.test", "is_bot", "true"),
+					resource.TestCheckResourceAttr("data.slack_user.test", "bot_id", botId),
+					resource.TestCheckResourceAttr("data.slack_user.not_found", "found", "false"),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,183 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/boolvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserSessionResetResource{}
+
+func NewUserSessionResetResource() resource.Resource {
+	return &UserSessionResetResource{}
+}
+
+// UserSessionResetResource defines the resource implementation.
+type UserSessionResetResource struct {
+	client *SlackClient
+}
+
+// UserSessionResetResourceModel describes the resource data model.
+type UserSessionResetResourceModel struct {
+	Id         types.String `tfsdk:"id"`
+	UserId     types.String `tfsdk:"user_id"`
+	MobileOnly types.Bool   `tfsdk:"mobile_only"`
+	WebOnly    types.Bool   `tfsdk:"web_only"`
+	Triggers   types.Map    `tfsdk:"triggers"`
+}
+
+func (r *UserSessionResetResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_session_reset"
+}
+
+func (r *UserSessionResetResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Ends the sessions of a user on all of their devices when it is created, and again whenever any of its arguments change,
+logging them out of Slack. Destroying the resource does nothing.
+### Required Permissions
+- A user token of an admin of the workspace or organization.
+` + "- `admin.users:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user whose sessions were reset.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user to log out.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"mobile_only": schema.BoolAttribute{
+				MarkdownDescription: "Only end the user's sessions on mobile devices.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Bool{
+					boolvalidator.ConflictsWith(path.MatchRoot("web_only")),
+				},
+			},
+			"web_only": schema.BoolAttribute{
+				MarkdownDescription: "Only end the user's sessions in web browsers.",
+				Optional:            true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"triggers": schema.MapAttribute{
+				MarkdownDescription: "Arbitrary values that reset the sessions again when changed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserSessionResetResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_user_session_reset")...)
+}
+
+func (r *UserSessionResetResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserSessionResetResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "admin.users.session.reset", func() error {
+		return client.apiCall(ctx, "admin.users.session.reset", url.Values{
+			"user_id":     {data.UserId.ValueString()},
+			"mobile_only": {strconv.FormatBool(data.MobileOnly.ValueBool())},
+			"web_only":    {strconv.FormatBool(data.WebOnly.ValueBool())},
+		}, &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to reset sessions of user: %s, got error: %s", data.UserId.ValueString(), err))
+		return
+	}
+
+	data.Id = data.UserId
+
+	tflog.Trace(ctx, "Reset the sessions of a slack user", map[string]any{"user_id": data.UserId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserSessionResetResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	// A reset is not tracked after the fact, so there is nothing to refresh.
+}
+
+func (r *UserSessionResetResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing
+	// to update.
+	var plan UserSessionResetResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserSessionResetResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	// Ended sessions cannot be restored.
+}
@@ -0,0 +1,54 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserSessionResetResource(t *testing.T) {
+	userId := testAccFixture(t, testEnvSessionUserId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create resets the sessions
+			{
+				Config: providerConfig + `
+resource "slack_user_session_reset" "test" {
+  user_id  = "` + userId + `"
+  triggers = {
+    incident = "INC-1"
+  }
+}
+
+data "slack_user_sessions" "test" {
+  user_id = slack_user_session_reset.test.user_id
+  team_id = data.slack_admin_users.team.id
+}
+
+data "slack_admin_users" "team" {
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_session_reset.test", "id", userId),
+					resource.TestCheckResourceAttr("data.slack_user_sessions.test", "sessions.#", "0"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "slack_user_session_reset" "conflicting" {
+  user_id     = "` + userId + `"
+  mobile_only = true
+  web_only    = true
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
@@ -0,0 +1,365 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserSessionSettingsResource{}
+var _ resource.ResourceWithImportState = &UserSessionSettingsResource{}
+var _ resource.ResourceWithValidateConfig = &UserSessionSettingsResource{}
+
+func NewUserSessionSettingsResource() resource.Resource {
+	return &UserSessionSettingsResource{}
+}
+
+// UserSessionSettingsResource defines the resource implementation.
+type UserSessionSettingsResource struct {
+	client *SlackClient
+}
+
+// UserSessionSettingsResourceModel describes the resource data model.
+type UserSessionSettingsResourceModel struct {
+	Id                    types.String `tfsdk:"id"`
+	UserIds               types.Set    `tfsdk:"user_ids"`
+	Duration              types.Int64  `tfsdk:"duration"`
+	DesktopAppBrowserQuit types.Bool   `tfsdk:"desktop_app_browser_quit"`
+}
+
+// sessionSettingsResponse is the response of admin.users.session.getSettings.
+type sessionSettingsResponse struct {
+	slack.SlackResponse
+	SessionSettings []struct {
+		UserId                string `json:"user_id"`
+		Duration              *int64 `json:"duration"`
+		DesktopAppBrowserQuit *bool  `json:"desktop_app_browser_quit"`
+	} `json:"session_settings"`
+	NoSettingsApplied []string `json:"no_settings_applied"`
+}
+
+func (r *UserSessionSettingsResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_session_settings"
+}
+
+func (r *UserSessionSettingsResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Overrides the session settings of an Enterprise Grid organization for some of its users.
+Users without an override, including those removed from ` + "`user_ids`" + ` or when the resource is destroyed, get the organization's default settings.
+### Required Permissions
+- An org-level user token of an Org Admin or Owner.
+` + "- `admin.users:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The sorted IDs of the users, separated by commas.",
+				Computed:            true,
+			},
+			"user_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the users the settings apply to.",
+				Required:            true,
+				ElementType:         types.StringType,
+				Validators: []validator.Set{
+					setvalidator.SizeAtLeast(1),
+				},
+			},
+			"duration": schema.Int64Attribute{
+				MarkdownDescription: "How long a session lasts, in seconds. At least 28800, or 8 hours.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.AtLeast(28800),
+				},
+			},
+			"desktop_app_browser_quit": schema.BoolAttribute{
+				MarkdownDescription: "Whether quitting the browser ends sessions in the desktop app and browser.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (r *UserSessionSettingsResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserSessionSettingsResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.Duration.IsNull() && data.DesktopAppBrowserQuit.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("duration"),
+			"Missing Attribute Configuration",
+			"At least one of duration or desktop_app_browser_quit must be set.",
+		)
+	}
+}
+
+func (r *UserSessionSettingsResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_user_session_settings")...)
+}
+
+// setSessionSettings applies data's session settings to userIds.
+func setSessionSettings(ctx context.Context, client *SlackClient, data UserSessionSettingsResourceModel, userIds []string) error {
+	values := url.Values{
+		"user_ids": {strings.Join(userIds, ",")},
+	}
+	if !data.Duration.IsNull() {
+		values.Set("duration", strconv.FormatInt(data.Duration.ValueInt64(), 10))
+	}
+	if !data.DesktopAppBrowserQuit.IsNull() {
+		values.Set("desktop_app_browser_quit", strconv.FormatBool(data.DesktopAppBrowserQuit.ValueBool()))
+	}
+
+	return client.retry(ctx, "admin.users.session.setSettings", func() error {
+		return client.apiCall(ctx, "admin.users.session.setSettings", values, &slack.SlackResponse{})
+	})
+}
+
+// clearSessionSettings returns userIds to the organization's default session
+// settings.
+func clearSessionSettings(ctx context.Context, client *SlackClient, userIds []string) error {
+	return client.retry(ctx, "admin.users.session.clearSettings", func() error {
+		return client.apiCall(ctx, "admin.users.session.clearSettings", url.Values{
+			"user_ids": {strings.Join(userIds, ",")},
+		}, &slack.SlackResponse{})
+	})
+}
+
+func sessionSettingsId(userIds []string) string {
+	userIds = slices.Clone(userIds)
+	slices.Sort(userIds)
+	return strings.Join(userIds, ",")
+}
+
+func (r *UserSessionSettingsResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserSessionSettingsResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := setSessionSettings(ctx, client, data, userIds); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set session settings, got error: %s", err))
+		return
+	}
+
+	data.Id = types.StringValue(sessionSettingsId(userIds))
+
+	tflog.Trace(ctx, "Set slack session settings", map[string]any{"user_ids": userIds})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserSessionSettingsResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserSessionSettingsResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var response sessionSettingsResponse
+
+	err := client.retry(ctx, "admin.users.session.getSettings", func() error {
+		return client.apiCall(ctx, "admin.users.session.getSettings", url.Values{
+			"user_ids": {strings.Join(userIds, ",")},
+		}, &response)
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read session settings, got error: %s", err))
+		return
+	}
+
+	// Every user whose settings were cleared outside of Terraform is gone,
+	// so the next apply sets them again.
+	if len(response.SessionSettings) == 0 {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	settingsUserIds := make([]string, 0, len(response.SessionSettings))
+	for _, settings := range response.SessionSettings {
+		settingsUserIds = append(settingsUserIds, settings.UserId)
+	}
+
+	userIdsValue, diags := types.SetValueFrom(ctx, types.StringType, settingsUserIds)
+	resp.Diagnostics.Append(diags...)
+	data.UserIds = userIdsValue
+	data.Id = types.StringValue(sessionSettingsId(settingsUserIds))
+
+	// Users set together share their settings, so the first user's stand for
+	// all of them.
+	settings := response.SessionSettings[0]
+	data.Duration = types.Int64PointerValue(settings.Duration)
+	data.DesktopAppBrowserQuit = types.BoolPointerValue(settings.DesktopAppBrowserQuit)
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserSessionSettingsResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan, state UserSessionSettingsResourceModel
+	client := r.client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds, previousUserIds []string
+	resp.Diagnostics.Append(plan.UserIds.ElementsAs(ctx, &userIds, false)...)
+	resp.Diagnostics.Append(state.UserIds.ElementsAs(ctx, &previousUserIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var removedUserIds []string
+	for _, userId := range previousUserIds {
+		if !slices.Contains(userIds, userId) {
+			removedUserIds = append(removedUserIds, userId)
+		}
+	}
+
+	if len(removedUserIds) > 0 {
+		if err := clearSessionSettings(ctx, client, removedUserIds); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear session settings, got error: %s", err))
+			return
+		}
+	}
+
+	// Settings left out of the configuration are only cleared by clearing
+	// all of a user's settings, so those are cleared before setting the rest.
+	if (plan.Duration.IsNull() && !state.Duration.IsNull()) ||
+		(plan.DesktopAppBrowserQuit.IsNull() && !state.DesktopAppBrowserQuit.IsNull()) {
+		if err := clearSessionSettings(ctx, client, userIds); err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear session settings, got error: %s", err))
+			return
+		}
+	}
+
+	if err := setSessionSettings(ctx, client, plan, userIds); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update session settings, got error: %s", err))
+		return
+	}
+
+	plan.Id = types.StringValue(sessionSettingsId(userIds))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserSessionSettingsResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserSessionSettingsResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userIds []string
+	resp.Diagnostics.Append(data.UserIds.ElementsAs(ctx, &userIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := clearSessionSettings(ctx, client, userIds); err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to clear session settings, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserSessionSettingsResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userIds := strings.Split(req.ID, ",")
+
+	if slices.Contains(userIds, "") {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: user_id,user_id,... Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), sessionSettingsId(userIds))...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_ids"), userIds)...)
+}
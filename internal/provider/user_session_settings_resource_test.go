@@ -0,0 +1,54 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserSessionSettingsResource(t *testing.T) {
+	userId := testAccFixture(t, testEnvSessionUserId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_user_session_settings" "test" {
+  user_ids = ["` + userId + `"]
+  duration = 86400
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_session_settings.test", "id", userId),
+					resource.TestCheckResourceAttr("slack_user_session_settings.test", "duration", "86400"),
+					resource.TestCheckNoResourceAttr("slack_user_session_settings.test", "desktop_app_browser_quit"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_user_session_settings.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_user_session_settings" "test" {
+  user_ids                 = ["` + userId + `"]
+  desktop_app_browser_quit = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckNoResourceAttr("slack_user_session_settings.test", "duration"),
+					resource.TestCheckResourceAttr("slack_user_session_settings.test", "desktop_app_browser_quit", "true"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
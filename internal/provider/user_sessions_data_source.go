@@ -0,0 +1,295 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &UserSessionsDataSource{}
+	_ datasource.DataSourceWithConfigure = &UserSessionsDataSource{}
+)
+
+func NewUserSessionsDataSource() datasource.DataSource {
+	return &UserSessionsDataSource{}
+}
+
+// UserSessionsDataSource defines the data source implementation.
+type UserSessionsDataSource struct {
+	client *SlackClient
+}
+
+// UserSessionsDataSourceModel describes the data source data model.
+type UserSessionsDataSourceModel struct {
+	Id       types.String `tfsdk:"id"`
+	UserId   types.String `tfsdk:"user_id"`
+	TeamId   types.String `tfsdk:"team_id"`
+	Sessions types.List   `tfsdk:"sessions"`
+}
+
+// UserSessionModel describes a single entry of sessions.
+type UserSessionModel struct {
+	SessionId types.String `tfsdk:"session_id"`
+	UserId    types.String `tfsdk:"user_id"`
+	TeamId    types.String `tfsdk:"team_id"`
+	Created   types.Object `tfsdk:"created"`
+	Recent    types.Object `tfsdk:"recent"`
+}
+
+// sessionClient is the client a session was created or last used from.
+type sessionClient struct {
+	DeviceHardware     string `json:"device_hardware"`
+	Os                 string `json:"os"`
+	OsVersion          string `json:"os_version"`
+	SlackClientVersion string `json:"slack_client_version"`
+	Ip                 string `json:"ip"`
+}
+
+// sessionsListResponse is the response of admin.users.session.list.
+type sessionsListResponse struct {
+	slack.SlackResponse
+	ActiveSessions []struct {
+		UserId    string         `json:"user_id"`
+		TeamId    string         `json:"team_id"`
+		SessionId int64          `json:"session_id"`
+		Created   *sessionClient `json:"created"`
+		Recent    *sessionClient `json:"recent"`
+	} `json:"active_sessions"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+var sessionClientAttrTypes = map[string]attr.Type{
+	"device_hardware":      types.StringType,
+	"os":                   types.StringType,
+	"os_version":           types.StringType,
+	"slack_client_version": types.StringType,
+	"ip":                   types.StringType,
+}
+
+var userSessionAttrTypes = map[string]attr.Type{
+	"session_id": types.StringType,
+	"user_id":    types.StringType,
+	"team_id":    types.StringType,
+	"created":    types.ObjectType{AttrTypes: sessionClientAttrTypes},
+	"recent":     types.ObjectType{AttrTypes: sessionClientAttrTypes},
+}
+
+func (d *UserSessionsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_sessions"
+}
+
+func sessionClientSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"device_hardware": schema.StringAttribute{
+				MarkdownDescription: "The device, such as `Mac` or `iPhone`.",
+				Computed:            true,
+			},
+			"os": schema.StringAttribute{
+				MarkdownDescription: "The operating system.",
+				Computed:            true,
+			},
+			"os_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the operating system.",
+				Computed:            true,
+			},
+			"slack_client_version": schema.StringAttribute{
+				MarkdownDescription: "The version of the Slack client.",
+				Computed:            true,
+			},
+			"ip": schema.StringAttribute{
+				MarkdownDescription: "The IP address.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserSessionsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the active sessions of a user, or of every user of the organization, for auditing them during incident response.
+Sessions can be ended with ` + "`slack_user_session_reset`" + `.
+### Required Permissions
+- An org-level user token of an Org Admin or Owner.
+- ` + "`admin.users:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user the sessions were read for, or the organization's workspace when unset.",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user to list sessions of. Lists the sessions of every user when unset.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.AlsoRequires(path.MatchRoot("team_id")),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace to list sessions in. Required with `user_id`.",
+				Optional:            true,
+			},
+			"sessions": schema.ListNestedAttribute{
+				MarkdownDescription: "The active sessions.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"session_id": schema.StringAttribute{
+							MarkdownDescription: "The session's ID.",
+							Computed:            true,
+						},
+						"user_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the session's user.",
+							Computed:            true,
+						},
+						"team_id": schema.StringAttribute{
+							MarkdownDescription: "The ID of the workspace the session belongs to.",
+							Computed:            true,
+						},
+						"created": sessionClientSchema("The client the session was created from."),
+						"recent":  sessionClientSchema("The client the session was last used from, if Slack reports it."),
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserSessionsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_user_sessions")...)
+}
+
+// sessionClientValue converts client to an object value, null when Slack did
+// not report it.
+func sessionClientValue(client *sessionClient) (basetypes.ObjectValue, diag.Diagnostics) {
+	if client == nil {
+		return types.ObjectNull(sessionClientAttrTypes), nil
+	}
+	return types.ObjectValue(sessionClientAttrTypes, map[string]attr.Value{
+		"device_hardware":      types.StringValue(client.DeviceHardware),
+		"os":                   types.StringValue(client.Os),
+		"os_version":           types.StringValue(client.OsVersion),
+		"slack_client_version": types.StringValue(client.SlackClientVersion),
+		"ip":                   types.StringValue(client.Ip),
+	})
+}
+
+func (d *UserSessionsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserSessionsDataSourceModel
+	client := d.client
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	sessions := []UserSessionModel{}
+	cursor := ""
+
+	for {
+		values := url.Values{
+			"cursor": {cursor},
+			"limit":  {"1000"},
+		}
+		if !data.UserId.IsNull() {
+			values.Set("user_id", data.UserId.ValueString())
+			values.Set("team_id", data.TeamId.ValueString())
+		} else if !data.TeamId.IsNull() {
+			values.Set("team_id", data.TeamId.ValueString())
+		}
+
+		var response sessionsListResponse
+
+		err := client.retry(ctx, "admin.users.session.list", func() error {
+			return client.apiCall(ctx, "admin.users.session.list", values, &response)
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list sessions, got error: %s", err))
+			return
+		}
+
+		for _, session := range response.ActiveSessions {
+			created, diags := sessionClientValue(session.Created)
+			resp.Diagnostics.Append(diags...)
+			recent, diags := sessionClientValue(session.Recent)
+			resp.Diagnostics.Append(diags...)
+
+			sessions = append(sessions, UserSessionModel{
+				SessionId: types.StringValue(fmt.Sprint(session.SessionId)),
+				UserId:    types.StringValue(session.UserId),
+				TeamId:    types.StringValue(session.TeamId),
+				Created:   created,
+				Recent:    recent,
+			})
+		}
+
+		cursor = response.ResponseMetadata.NextCursor
+		if cursor == "" {
+			break
+		}
+	}
+
+	// Set data from API response.
+	id := data.UserId.ValueString()
+	if id == "" {
+		id = data.TeamId.ValueString()
+	}
+	if id == "" {
+		id = client.teamId
+	}
+	data.Id = types.StringValue(id)
+
+	sessionsValue, diags := types.ListValueFrom(ctx, types.ObjectType{AttrTypes: userSessionAttrTypes}, sessions)
+	resp.Diagnostics.Append(diags...)
+	data.Sessions = sessionsValue
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
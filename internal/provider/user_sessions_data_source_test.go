@@ -0,0 +1,51 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserSessionsDataSource(t *testing.T) {
+	userId := testAccFixture(t, testEnvSessionUserId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccUserSessionsDataSourceConfig(userId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_user_sessions.test", "id", userId),
+					resource.TestCheckResourceAttrSet("data.slack_user_sessions.test", "sessions.0.session_id"),
+					resource.TestCheckResourceAttr("data.slack_user_sessions.test", "sessions.0.user_id", userId),
+					resource.TestCheckResourceAttrSet("data.slack_user_sessions.test", "sessions.0.created.ip"),
+				),
+			},
+			{
+				Config: providerConfig + `
+data "slack_user_sessions" "missing_team" {
+  user_id = "` + userId + `"
+}
+`,
+				ExpectError: regexp.MustCompile(`Invalid Attribute Combination`),
+			},
+		},
+	})
+}
+
+func testAccUserSessionsDataSourceConfig(userId string) string {
+	return `
+data "slack_user_sessions" "test" {
+  user_id = "` + userId + `"
+  team_id = data.slack_admin_users.team.id
+}
+
+data "slack_admin_users" "team" {
+}
+`
+}
@@ -0,0 +1,201 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"cmp"
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &UserUserGroupsDataSource{}
+	_ datasource.DataSourceWithConfigure = &UserUserGroupsDataSource{}
+)
+
+func NewUserUserGroupsDataSource() datasource.DataSource {
+	return &UserUserGroupsDataSource{}
+}
+
+// UserUserGroupsDataSource defines the data source implementation.
+type UserUserGroupsDataSource struct {
+	client *SlackClient
+}
+
+// UserUserGroupsDataSourceModel describes the data source data model.
+type UserUserGroupsDataSourceModel struct {
+	Id              types.String `tfsdk:"id"`
+	UserId          types.String `tfsdk:"user_id"`
+	IncludeDisabled types.Bool   `tfsdk:"include_disabled"`
+	UserGroupIds    types.Set    `tfsdk:"usergroup_ids"`
+	UserGroups      types.List   `tfsdk:"usergroups"`
+}
+
+// UserUserGroupModel describes a single entry of usergroups.
+type UserUserGroupModel struct {
+	Id       types.String `tfsdk:"id"`
+	Name     types.String `tfsdk:"name"`
+	Handle   types.String `tfsdk:"handle"`
+	Disabled types.Bool   `tfsdk:"disabled"`
+}
+
+var userUserGroupAttrTypes = map[string]attr.Type{
+	"id":       types.StringType,
+	"name":     types.StringType,
+	"handle":   types.StringType,
+	"disabled": types.BoolType,
+}
+
+func (d *UserUserGroupsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_usergroups"
+}
+
+func (d *UserUserGroupsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the User Groups a user is a member of, such as for access reviews or to check that an offboarded user was removed from every User Group.
+### Required Permissions
+- ` + "`usergroups:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user.",
+				Computed:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user to get the User Groups of.",
+				Required:            true,
+			},
+			"include_disabled": schema.BoolAttribute{
+				MarkdownDescription: "Set true to include disabled User Groups the user was a member of.",
+				Optional:            true,
+			},
+			"usergroup_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the User Groups the user is a member of.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"usergroups": schema.ListNestedAttribute{
+				MarkdownDescription: "Details of each User Group the user is a member of, ordered by ID.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"id": schema.StringAttribute{
+							MarkdownDescription: "The User Group's ID.",
+							Computed:            true,
+						},
+						"name": schema.StringAttribute{
+							MarkdownDescription: "The User Group's name.",
+							Computed:            true,
+						},
+						"handle": schema.StringAttribute{
+							MarkdownDescription: "The User Group's mention handle.",
+							Computed:            true,
+						},
+						"disabled": schema.BoolAttribute{
+							MarkdownDescription: "Whether the User Group is disabled.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *UserUserGroupsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserUserGroupsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserUserGroupsDataSourceModel
+	client := d.client
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var userGroups []slack.UserGroup
+
+	// Listing every User Group with its members takes a single call, rather
+	// than one usergroups.users.list per User Group.
+	err := client.retry(ctx, "usergroups.list", func() (err error) {
+		userGroups, err = client.GetUserGroupsContext(
+			ctx,
+			slack.GetUserGroupsOptionIncludeUsers(true),
+			slack.GetUserGroupsOptionIncludeDisabled(data.IncludeDisabled.ValueBool()),
+		)
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list User Groups, got error: %s", err))
+		return
+	}
+
+	slices.SortFunc(userGroups, func(a, b slack.UserGroup) int { return cmp.Compare(a.ID, b.ID) })
+
+	userGroupIds := []string{}
+	memberOf := []UserUserGroupModel{}
+
+	for _, userGroup := range userGroups {
+		if !slices.Contains(userGroup.Users, data.UserId.ValueString()) {
+			continue
+		}
+		userGroupIds = append(userGroupIds, userGroup.ID)
+		memberOf = append(memberOf, UserUserGroupModel{
+			Id:       types.StringValue(userGroup.ID),
+			Name:     types.StringValue(userGroup.Name),
+			Handle:   types.StringValue(userGroup.Handle),
+			Disabled: types.BoolValue(userGroup.DateDelete != 0),
+		})
+	}
+
+	// Set data from API response.
+	data.Id = data.UserId
+
+	var diags diag.Diagnostics
+
+	data.UserGroupIds, diags = types.SetValueFrom(ctx, types.StringType, userGroupIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.UserGroups, diags = types.ListValueFrom(ctx, types.ObjectType{AttrTypes: userUserGroupAttrTypes}, memberOf)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,48 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserUserGroupsDataSource(t *testing.T) {
+	userGroupId := testAccFixture(t, testEnvUserGroupId)
+	memberId := testAccFixture(t, testEnvUserGroupMember)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccUserUserGroupsDataSourceConfig(memberId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_user_usergroups.test", "id", memberId),
+					resource.TestCheckTypeSetElemAttr("data.slack_user_usergroups.test", "usergroup_ids.*", userGroupId),
+					resource.TestCheckTypeSetElemNestedAttrs("data.slack_user_usergroups.test", "usergroups.*", map[string]string{
+						"id":       userGroupId,
+						"disabled": "false",
+					}),
+				),
+			},
+			{
+				Config: providerConfig + testAccUserUserGroupsDataSourceConfig("UDOESNOTEXIST"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_user_usergroups.test", "usergroup_ids.#", "0"),
+					resource.TestCheckResourceAttr("data.slack_user_usergroups.test", "usergroups.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testAccUserUserGroupsDataSourceConfig(userId string) string {
+	return `
+data "slack_user_usergroups" "test" {
+  user_id = "` + userId + `"
+}
+`
+}
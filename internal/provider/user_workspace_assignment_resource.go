@@ -0,0 +1,455 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"strconv"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserWorkspaceAssignmentResource{}
+var _ resource.ResourceWithImportState = &UserWorkspaceAssignmentResource{}
+var _ resource.ResourceWithValidateConfig = &UserWorkspaceAssignmentResource{}
+
+func NewUserWorkspaceAssignmentResource() resource.Resource {
+	return &UserWorkspaceAssignmentResource{}
+}
+
+// UserWorkspaceAssignmentResource defines the resource implementation.
+type UserWorkspaceAssignmentResource struct {
+	client *SlackClient
+}
+
+// UserWorkspaceAssignmentResourceModel describes the resource data model.
+type UserWorkspaceAssignmentResourceModel struct {
+	Id                types.String `tfsdk:"id"`
+	TeamId            types.String `tfsdk:"team_id"`
+	UserId            types.String `tfsdk:"user_id"`
+	IsRestricted      types.Bool   `tfsdk:"is_restricted"`
+	IsUltraRestricted types.Bool   `tfsdk:"is_ultra_restricted"`
+	ChannelIds        types.Set    `tfsdk:"channel_ids"`
+	ExpirationTs      types.Int64  `tfsdk:"expiration_ts"`
+}
+
+// adminUser is a user as returned by admin.users.list.
+type adminUser struct {
+	ID                string `json:"id"`
+	Email             string `json:"email"`
+	IsAdmin           bool   `json:"is_admin"`
+	IsOwner           bool   `json:"is_owner"`
+	IsPrimaryOwner    bool   `json:"is_primary_owner"`
+	IsRestricted      bool   `json:"is_restricted"`
+	IsUltraRestricted bool   `json:"is_ultra_restricted"`
+	IsBot             bool   `json:"is_bot"`
+	Has2fa            bool   `json:"has_2fa"`
+	ExpirationTs      int64  `json:"expiration_ts"`
+}
+
+// adminUsersListResponse is the response of admin.users.list.
+type adminUsersListResponse struct {
+	slack.SlackResponse
+	Users            []adminUser `json:"users"`
+	ResponseMetadata struct {
+		NextCursor string `json:"next_cursor"`
+	} `json:"response_metadata"`
+}
+
+func (r *UserWorkspaceAssignmentResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_user_workspace_assignment"
+}
+
+func (r *UserWorkspaceAssignmentResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Adds a user of an Enterprise Grid organization to one of its workspaces, and removes them from it on destroy.
+### Required Permissions
+- An org-level user token of an Org Admin or Owner.
+` + "- `admin.users:read`" + `
+` + "- `admin.users:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the assignment, in the form `team_id:user_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"team_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the workspace to add the user to.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the user to add.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"is_restricted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a multi-channel guest of the workspace.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"is_ultra_restricted": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a single-channel guest of the workspace.",
+				Optional:            true,
+				Computed:            true,
+				Default:             booldefault.StaticBool(false),
+			},
+			"channel_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the channels a guest is added to when assigned. " +
+					"Slack does not report these back, so they are only used when the user is added to the workspace, and changing them adds the user again.",
+				Optional:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"expiration_ts": schema.Int64Attribute{
+				MarkdownDescription: "When the guest account expires, as a Unix timestamp. Only valid for guests. " +
+					"Slack cannot remove an expiration, so leaving it unset stops managing it rather than clearing it.",
+				Optional: true,
+			},
+		},
+	}
+}
+
+func (r *UserWorkspaceAssignmentResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var data UserWorkspaceAssignmentResourceModel
+
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if data.IsRestricted.IsUnknown() || data.IsUltraRestricted.IsUnknown() {
+		return
+	}
+
+	if data.IsRestricted.ValueBool() && data.IsUltraRestricted.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("is_restricted"),
+			"Invalid Attribute Combination",
+			"A user cannot be both a multi-channel and a single-channel guest, so is_restricted and is_ultra_restricted cannot both be true.",
+		)
+		return
+	}
+
+	if data.ExpirationTs.IsNull() {
+		return
+	}
+
+	if !data.IsRestricted.ValueBool() && !data.IsUltraRestricted.ValueBool() {
+		resp.Diagnostics.AddAttributeError(
+			path.Root("expiration_ts"),
+			"Invalid Attribute Combination",
+			"expiration_ts can only be set for guests, with is_restricted or is_ultra_restricted.",
+		)
+	}
+}
+
+// setGuestExpiration sets when a guest's account in a workspace expires.
+func setGuestExpiration(ctx context.Context, client *SlackClient, data UserWorkspaceAssignmentResourceModel) error {
+	return client.retry(ctx, "admin.users.setExpiration", func() error {
+		return client.apiCall(ctx, "admin.users.setExpiration", url.Values{
+			"team_id":       {data.TeamId.ValueString()},
+			"user_id":       {data.UserId.ValueString()},
+			"expiration_ts": {strconv.FormatInt(data.ExpirationTs.ValueInt64(), 10)},
+		}, &slack.SlackResponse{})
+	})
+}
+
+func (r *UserWorkspaceAssignmentResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+
+	resp.Diagnostics.Append(client.requireUserToken("slack_user_workspace_assignment")...)
+}
+
+func (r *UserWorkspaceAssignmentResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserWorkspaceAssignmentResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var channelIds []string
+	resp.Diagnostics.Append(data.ChannelIds.ElementsAs(ctx, &channelIds, false)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	values := url.Values{
+		"team_id":             {data.TeamId.ValueString()},
+		"user_id":             {data.UserId.ValueString()},
+		"is_restricted":       {strconv.FormatBool(data.IsRestricted.ValueBool())},
+		"is_ultra_restricted": {strconv.FormatBool(data.IsUltraRestricted.ValueBool())},
+	}
+	if len(channelIds) > 0 {
+		values.Set("channel_ids", strings.Join(channelIds, ","))
+	}
+
+	err := client.retry(ctx, "admin.users.assign", func() error {
+		return client.apiCall(ctx, "admin.users.assign", values, &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to assign user: %s to workspace: %s, got error: %s", data.UserId.ValueString(), data.TeamId.ValueString(), err))
+		return
+	}
+
+	data.Id = types.StringValue(data.TeamId.ValueString() + ":" + data.UserId.ValueString())
+
+	if !data.ExpirationTs.IsNull() {
+		err := setGuestExpiration(ctx, client, data)
+
+		if err != nil {
+			// The user was assigned, so keep it in state and let the next
+			// apply set the expiration again.
+			data.ExpirationTs = types.Int64Null()
+			resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set expiration of guest: %s, got error: %s", data.UserId.ValueString(), err))
+			return
+		}
+	}
+
+	tflog.Trace(ctx, "Assigned a slack user to a workspace", map[string]any{"user_id": data.UserId.ValueString(), "team_id": data.TeamId.ValueString()})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserWorkspaceAssignmentResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserWorkspaceAssignmentResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	user, err := getAdminUser(ctx, client, data.TeamId.ValueString(), data.UserId.ValueString())
+
+	if err != nil {
+		if err.Error() == "user_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read workspace users, got error: %s", err))
+		return
+	}
+
+	data.IsRestricted = types.BoolValue(user.IsRestricted)
+	data.IsUltraRestricted = types.BoolValue(user.IsUltraRestricted)
+	if !data.ExpirationTs.IsNull() {
+		data.ExpirationTs = types.Int64Value(user.ExpirationTs)
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserWorkspaceAssignmentResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan, state UserWorkspaceAssignmentResourceModel
+	client := r.client
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only the user's role and expiration change in place, everything else
+	// replaces the resource. The role is only set when it changed, so
+	// extending a guest's expiration doesn't set it again.
+	if plan.IsRestricted.ValueBool() != state.IsRestricted.ValueBool() ||
+		plan.IsUltraRestricted.ValueBool() != state.IsUltraRestricted.ValueBool() {
+		method := "admin.users.setRegular"
+		switch {
+		case plan.IsRestricted.ValueBool():
+			method = "admin.users.setRestricted"
+		case plan.IsUltraRestricted.ValueBool():
+			method = "admin.users.setUltraRestricted"
+		}
+
+		err := client.retry(ctx, method, func() error {
+			return client.apiCall(ctx, method, url.Values{
+				"team_id": {plan.TeamId.ValueString()},
+				"user_id": {plan.UserId.ValueString()},
+			}, &slack.SlackResponse{})
+		})
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to update user: %s in workspace: %s, got error: %s", plan.UserId.ValueString(), plan.TeamId.ValueString(), err))
+			return
+		}
+	}
+
+	if !plan.ExpirationTs.IsNull() && !plan.ExpirationTs.Equal(state.ExpirationTs) {
+		err := setGuestExpiration(ctx, client, plan)
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to set expiration of guest: %s, got error: %s", plan.UserId.ValueString(), err))
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserWorkspaceAssignmentResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserWorkspaceAssignmentResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	err := client.retry(ctx, "admin.users.remove", func() error {
+		return client.apiCall(ctx, "admin.users.remove", url.Values{
+			"team_id": {data.TeamId.ValueString()},
+			"user_id": {data.UserId.ValueString()},
+		}, &slack.SlackResponse{})
+	})
+
+	if err != nil {
+		if err.Error() == "user_not_found" {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove user from workspace, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserWorkspaceAssignmentResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	teamId, userId, ok := strings.Cut(req.ID, ":")
+
+	if !ok || teamId == "" || userId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: team_id:user_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("team_id"), teamId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_id"), userId)...)
+}
+
+// listAdminUsers pages through the members of a workspace of an Enterprise
+// Grid organization, as listed by admin.users.list. Listing stops early once
+// found returns true.
+func listAdminUsers(ctx context.Context, client *SlackClient, teamId string, found func(user adminUser) bool) error {
+	cursor := ""
+
+	for {
+		var response adminUsersListResponse
+
+		err := client.retry(ctx, "admin.users.list", func() error {
+			return client.apiCall(ctx, "admin.users.list", url.Values{
+				"team_id": {teamId},
+				"cursor":  {cursor},
+				"limit":   {"100"},
+			}, &response)
+		})
+
+		if err != nil {
+			return err
+		}
+
+		for _, user := range response.Users {
+			if found(user) {
+				return nil
+			}
+		}
+
+		cursor = response.ResponseMetadata.NextCursor
+		if cursor == "" {
+			return nil
+		}
+	}
+}
+
+// getAdminUser finds a user among the members of a workspace of an Enterprise
+// Grid organization.
+func getAdminUser(ctx context.Context, client *SlackClient, teamId string, userId string) (*adminUser, error) {
+	var match *adminUser
+
+	err := listAdminUsers(ctx, client, teamId, func(user adminUser) bool {
+		if user.ID == userId {
+			match = &user
+		}
+		return match != nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+	if match == nil {
+		return nil, fmt.Errorf("user_not_found")
+	}
+	return match, nil
+}
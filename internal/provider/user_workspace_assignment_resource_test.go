@@ -0,0 +1,105 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserWorkspaceAssignmentResource(t *testing.T) {
+	teamId := testAccFixture(t, testEnvAssignTeamId)
+	userId := testAccFixture(t, testEnvUserId)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_user_workspace_assignment" "test" {
+  team_id = "` + teamId + `"
+  user_id = "` + userId + `"
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_workspace_assignment.test", "id", teamId+":"+userId),
+					resource.TestCheckResourceAttr("slack_user_workspace_assignment.test", "is_restricted", "false"),
+					resource.TestCheckResourceAttr("slack_user_workspace_assignment.test", "is_ultra_restricted", "false"),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_user_workspace_assignment.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Update and Read testing
+			{
+				Config: providerConfig + `
+resource "slack_user_workspace_assignment" "test" {
+  team_id       = "` + teamId + `"
+  user_id       = "` + userId + `"
+  is_restricted = true
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_workspace_assignment.test", "is_restricted", "true"),
+					resource.TestCheckResourceAttr("slack_user_workspace_assignment.test", "is_ultra_restricted", "false"),
+				),
+			},
+			// Guest expiration
+			{
+				Config: providerConfig + `
+resource "slack_user_workspace_assignment" "test" {
+  team_id       = "` + teamId + `"
+  user_id       = "` + userId + `"
+  is_restricted = true
+  expiration_ts = 4102444800
+}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_user_workspace_assignment.test", "expiration_ts", "4102444800"),
+				),
+			},
+			{
+				Config: providerConfig + `
+resource "slack_user_workspace_assignment" "test" {
+  team_id       = "` + teamId + `"
+  user_id       = "` + userId + `"
+  expiration_ts = 4102444800
+}
+`,
+				ExpectError: regexp.MustCompile(`expiration_ts can only be set for guests`),
+			},
+			{
+				Config: providerConfig + `
+resource "slack_user_workspace_assignment" "test" {
+  team_id             = "` + teamId + `"
+  user_id             = "` + userId + `"
+  is_restricted       = true
+  is_ultra_restricted = true
+}
+`,
+				ExpectError: regexp.MustCompile(`cannot both be true`),
+			},
+			// is_restricted = false does not conflict with is_ultra_restricted.
+			{
+				Config: providerConfig + `
+resource "slack_user_workspace_assignment" "test" {
+  team_id             = "` + teamId + `"
+  user_id             = "` + userId + `"
+  is_restricted       = false
+  is_ultra_restricted = true
+}
+`,
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
@@ -0,0 +1,282 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ resource.Resource = &UserGroupChannelResource{}
+var _ resource.ResourceWithImportState = &UserGroupChannelResource{}
+
+func NewUserGroupChannelResource() resource.Resource {
+	return &UserGroupChannelResource{}
+}
+
+// UserGroupChannelResource defines the resource implementation.
+type UserGroupChannelResource struct {
+	client *SlackClient
+}
+
+// UserGroupChannelResourceModel describes the resource data model.
+type UserGroupChannelResourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	UserGroupId types.String `tfsdk:"usergroup_id"`
+	ChannelId   types.String `tfsdk:"channel_id"`
+}
+
+func (r *UserGroupChannelResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup_channel"
+}
+
+func (r *UserGroupChannelResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Adds a default channel to a User Group, leaving its other default channels as they are.
+Members of the User Group are invited to its default channels.
+### Required Permissions
+` + "- `usergroups:read`" + `
+` + "- `usergroups:write`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the association, in the form `usergroup_id:channel_id`.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"usergroup_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the User Group.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"channel_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the channel to add to the User Group's default channels.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *UserGroupChannelResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+// getUserGroupChannels returns the default channels of the enabled User
+// Group with the given ID, failing with usergroup_not_found when there is
+// none.
+func getUserGroupChannels(ctx context.Context, client *SlackClient, userGroupId string) ([]string, error) {
+	userGroups, err := userGroupsList(ctx, client)
+	if err != nil {
+		return nil, err
+	}
+
+	userGroup, err := getUserGroupById(&userGroups, userGroupId)
+	if err != nil {
+		return nil, fmt.Errorf("usergroup_not_found")
+	}
+
+	return userGroup.Prefs.Channels, nil
+}
+
+// setUserGroupChannels replaces the default channels of a User Group.
+func setUserGroupChannels(ctx context.Context, client *SlackClient, userGroupId string, channels []string) error {
+	return client.retry(ctx, "usergroups.update", func() error {
+		_, err := client.UpdateUserGroupContext(ctx, userGroupId, slack.UpdateUserGroupsOptionChannels(channels))
+		return err
+	})
+}
+
+func (r *UserGroupChannelResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserGroupChannelResourceModel
+	client := r.client
+
+	// Read Terraform plan data into the model
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroupId := data.UserGroupId.ValueString()
+	channelId := data.ChannelId.ValueString()
+
+	// Default channels are replaced as a whole, so concurrent additions to
+	// the same User Group would overwrite each other.
+	unlock := client.lockUserGroup(userGroupId)
+	defer unlock()
+
+	channels, err := getUserGroupChannels(ctx, client, userGroupId)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read User Group: %s, got error: %s", userGroupId, err))
+		return
+	}
+
+	if !slices.Contains(channels, channelId) {
+		err = setUserGroupChannels(ctx, client, userGroupId, append(channels, channelId))
+
+		if err != nil {
+			resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to add channel %s to User Group: %s, got error: %s", channelId, userGroupId, err))
+			return
+		}
+	}
+
+	data.Id = types.StringValue(userGroupId + ":" + channelId)
+
+	tflog.Trace(ctx, "Added a default channel to a slack User Group", map[string]any{"usergroup_id": userGroupId, "channel_id": channelId})
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserGroupChannelResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserGroupChannelResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	channels, err := getUserGroupChannels(ctx, client, data.UserGroupId.ValueString())
+
+	if err != nil {
+		if err.Error() == "usergroup_not_found" {
+			resp.State.RemoveResource(ctx)
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read User Group, got error: %s", err))
+		return
+	}
+
+	// The channel was removed from the User Group outside of Terraform.
+	if !slices.Contains(channels, data.ChannelId.ValueString()) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	// Save updated data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
+
+func (r *UserGroupChannelResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	// Every configurable attribute requires replacement, so there is nothing
+	// to update.
+	var plan UserGroupChannelResourceModel
+
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *UserGroupChannelResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserGroupChannelResourceModel
+	client := r.client
+
+	// Read Terraform prior state data into the model
+	resp.Diagnostics.Append(req.State.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	userGroupId := data.UserGroupId.ValueString()
+
+	unlock := client.lockUserGroup(userGroupId)
+	defer unlock()
+
+	channels, err := getUserGroupChannels(ctx, client, userGroupId)
+
+	if err != nil {
+		if err.Error() == "usergroup_not_found" {
+			return
+		}
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to read User Group, got error: %s", err))
+		return
+	}
+
+	remaining := slices.DeleteFunc(slices.Clone(channels), func(channelId string) bool {
+		return channelId == data.ChannelId.ValueString()
+	})
+
+	if len(remaining) == len(channels) {
+		return
+	}
+
+	err = setUserGroupChannels(ctx, client, userGroupId, remaining)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to remove channel from User Group, got error: %s", err))
+		return
+	}
+}
+
+func (r *UserGroupChannelResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	userGroupId, channelId, ok := strings.Cut(req.ID, ":")
+
+	if !ok || userGroupId == "" || channelId == "" {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			fmt.Sprintf("Expected import identifier with format: usergroup_id:channel_id. Got: %q", req.ID),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("usergroup_id"), userGroupId)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("channel_id"), channelId)...)
+}
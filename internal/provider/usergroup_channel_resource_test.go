@@ -0,0 +1,68 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserGroupChannelResource(t *testing.T) {
+	channelId := testAccFixture(t, testEnvChannelId)
+	otherChannelId := testAccFixture(t, testEnvMembersChannelId)
+	userGroupName := "test-usergroup-" + testAccNameSuffix(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			// Create and Read testing
+			{
+				Config: providerConfig + testAccUserGroupChannelResourceConfig(userGroupName, channelId, otherChannelId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair("slack_usergroup_channel.test", "usergroup_id", "slack_usergroup.test", "id"),
+					resource.TestCheckResourceAttr("slack_usergroup_channel.test", "channel_id", channelId),
+					resource.TestCheckResourceAttr("slack_usergroup_channel.other", "channel_id", otherChannelId),
+				),
+			},
+			// ImportState testing
+			{
+				ResourceName:      "slack_usergroup_channel.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			// Removing one channel leaves the other in place
+			{
+				Config: providerConfig + testAccUserGroupChannelResourceConfig(userGroupName, channelId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("slack_usergroup_channel.test", "channel_id", channelId),
+					resource.TestCheckNoResourceAttr("slack_usergroup_channel.other", "channel_id"),
+				),
+			},
+			// Delete testing automatically occurs in TestCase
+		},
+	})
+}
+
+func testAccUserGroupChannelResourceConfig(userGroupName string, channelId string, otherChannelId ...string) string {
+	config := `
+resource "slack_usergroup" "test" {
+  name = "` + userGroupName + `"
+}
+
+resource "slack_usergroup_channel" "test" {
+  usergroup_id = slack_usergroup.test.id
+  channel_id   = "` + channelId + `"
+}
+`
+	for _, id := range otherChannelId {
+		config += `
+resource "slack_usergroup_channel" "other" {
+  usergroup_id = slack_usergroup.test.id
+  channel_id   = "` + id + `"
+}
+`
+	}
+	return config
+}
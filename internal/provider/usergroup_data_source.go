@@ -28,7 +28,7 @@ func NewUserGroupDataSource() datasource.DataSource {
 
 // UserGroupDataSource defines the data source implementation.
 type UserGroupDataSource struct {
-	client *slack.Client
+	client *SlackClient
 }
 
 // UserGroupDataSourceModel describes the data source data model.
@@ -45,6 +45,7 @@ func (d *UserGroupDataSource) ConfigValidators(ctx context.Context) []datasource
 		datasourcevalidator.Conflicting(
 			path.MatchRoot("id"),
 			path.MatchRoot("handle"),
+			path.MatchRoot("name"),
 		),
 	}
 }
@@ -57,7 +58,7 @@ func (d *UserGroupDataSource) Schema(ctx context.Context, req datasource.SchemaR
 	resp.Schema = schema.Schema{
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: `
-Reads a slack User Group specified by handle or id.
+Reads a slack User Group specified by id, handle or name.
 ### Required Permissions
 - ` + "`usergroups:read`" + `
 `,
@@ -73,7 +74,8 @@ Reads a slack User Group specified by handle or id.
 				Computed:            true,
 			},
 			"name": schema.StringAttribute{
-				MarkdownDescription: "A name for the User Group.",
+				MarkdownDescription: "The name of the User Group. Useful to look up User Groups without a handle.",
+				Optional:            true,
 				Computed:            true,
 			},
 			"description": schema.StringAttribute{
@@ -94,12 +96,12 @@ func (d *UserGroupDataSource) Configure(ctx context.Context, req datasource.Conf
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*SlackClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Data Source Configure Type",
-			fmt.Sprintf("Expected *slack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -109,6 +111,9 @@ func (d *UserGroupDataSource) Configure(ctx context.Context, req datasource.Conf
 }
 
 func (d *UserGroupDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data UserGroupDataSourceModel
 	var userGroup slack.UserGroup
 	var err error
@@ -122,23 +127,43 @@ func (d *UserGroupDataSource) Read(ctx context.Context, req datasource.ReadReque
 		return
 	}
 
-	userGroups, err := userGroupsList(ctx, client)
-
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find User Group, got error: %s", err))
-		return
-	}
+	var find func(userGroups *[]slack.UserGroup) (slack.UserGroup, error)
 
 	switch {
 	case !data.Id.IsNull():
-		userGroup, err = getUserGroupById(&userGroups, data.Id.ValueString())
+		find = func(userGroups *[]slack.UserGroup) (slack.UserGroup, error) {
+			return getUserGroupById(userGroups, data.Id.ValueString())
+		}
 	case !data.Handle.IsNull():
-		userGroup, err = getUserGroupByHandle(&userGroups, data.Handle.ValueString())
+		find = func(userGroups *[]slack.UserGroup) (slack.UserGroup, error) {
+			return getUserGroupByHandle(userGroups, data.Handle.ValueString())
+		}
+	case !data.Name.IsNull():
+		find = func(userGroups *[]slack.UserGroup) (slack.UserGroup, error) {
+			return getUserGroupByName(userGroups, data.Name.ValueString())
+		}
 	default:
-		resp.Diagnostics.AddError("Provider Error", "One of ID or Handle needs to be provided.")
+		resp.Diagnostics.AddError("Provider Error", "One of ID, Handle or Name needs to be provided.")
 		return
 	}
 
+	userGroups, err := cachedList(ctx, client, "usergroups.list",
+		func(userGroups []slack.UserGroup) bool {
+			_, err := find(&userGroups)
+			return err == nil
+		},
+		func() ([]slack.UserGroup, error) {
+			return userGroupsList(ctx, client)
+		},
+	)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find User Group, got error: %s", err))
+		return
+	}
+
+	userGroup, err = find(&userGroups)
+
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find User Group, got error: %s", err))
 		return
@@ -176,3 +201,14 @@ func getUserGroupByHandle(userGroups *[]slack.UserGroup, handle string) (slack.U
 
 	return slack.UserGroup{}, fmt.Errorf("could not find user group %s", handle)
 }
+
+func getUserGroupByName(userGroups *[]slack.UserGroup, name string) (slack.UserGroup, error) {
+
+	for _, each := range *userGroups {
+		if each.Name == name {
+			return each, nil
+		}
+	}
+
+	return slack.UserGroup{}, fmt.Errorf("could not find user group %s", name)
+}
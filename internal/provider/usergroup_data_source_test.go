@@ -10,19 +10,22 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 )
 
-const testUserGroupId = "S085R0X76CX"
-const testUserGroupHandle = "test-group"
-
 func TestAccUserGroupDataSource(t *testing.T) {
+	userGroupId := testAccFixture(t, testEnvUserGroupId)
+	userGroupHandle := testAccFixture(t, testEnvUserGroupHandle)
+	userGroupName := testAccFixture(t, testEnvUserGroupName)
+
 	resource.Test(t, resource.TestCase{
 		PreCheck:                 func() { testAccPreCheck(t) },
-		ProtoV6ProviderFactories: testAccProtoV6ProviderFactories,
+		ProtoV6ProviderFactories: testAccProviders(t),
 		Steps: []resource.TestStep{
 			{
-				Config: providerConfig + testAccUserGroupDataSourceConfig,
+				Config: providerConfig + testAccUserGroupDataSourceConfig(userGroupId, userGroupHandle, userGroupName),
 				Check: resource.ComposeAggregateTestCheckFunc(
-					resource.TestCheckResourceAttr("data.slack_usergroup.test_by_id", "handle", testUserGroupHandle),
-					resource.TestCheckResourceAttr("data.slack_usergroup.test_by_handle", "id", testUserGroupId),
+					resource.TestCheckResourceAttr("data.slack_usergroup.test_by_id", "handle", userGroupHandle),
+					resource.TestCheckResourceAttr("data.slack_usergroup.test_by_handle", "id", userGroupId),
+					resource.TestCheckResourceAttr("data.slack_usergroup.test_by_name", "id", userGroupId),
+					resource.TestCheckResourceAttr("data.slack_usergroup.test_by_name", "handle", userGroupHandle),
 				),
 			},
 			{
@@ -36,14 +39,19 @@ func TestAccUserGroupDataSource(t *testing.T) {
 	})
 }
 
-const testAccUserGroupDataSourceConfig = `
+func testAccUserGroupDataSourceConfig(id string, handle string, name string) string {
+	return `
 data "slack_usergroup" "test_by_id" {
-  id = "` + testUserGroupId + `"
+  id = "` + id + `"
 }
 data "slack_usergroup" "test_by_handle" {
-  handle = "` + testUserGroupHandle + `"
+  handle = "` + handle + `"
+}
+data "slack_usergroup" "test_by_name" {
+  name = "` + name + `"
 }
 `
+}
 
 const testAccUserGroupDoesNotExistDataSourceConfig = `
 data "slack_usergroup" "does_not_exist" {
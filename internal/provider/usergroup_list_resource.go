@@ -0,0 +1,104 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/list"
+	"github.com/hashicorp/terraform-plugin-framework/list/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ list.ListResource = &UserGroupListResource{}
+var _ list.ListResourceWithConfigure = &UserGroupListResource{}
+
+func NewUserGroupListResource() list.ListResource {
+	return &UserGroupListResource{}
+}
+
+// UserGroupListResource defines the list resource implementation.
+type UserGroupListResource struct {
+	client *SlackClient
+}
+
+func (r *UserGroupListResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup"
+}
+
+func (r *UserGroupListResource) ListResourceConfigSchema(ctx context.Context, req list.ListResourceSchemaRequest, resp *list.ListResourceSchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Lists the User Groups in the workspace so they can be imported as ` + "`slack_usergroup`" + ` resources. Disabled User Groups are not listed.
+### Required Permissions
+` + "- `usergroups:read`" + `
+`,
+	}
+}
+
+func (r *UserGroupListResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected List Resource Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	r.client = client
+}
+
+func (r *UserGroupListResource) List(ctx context.Context, req list.ListRequest, stream *list.ListResultsStream) {
+	var diags diag.Diagnostics
+	client := r.client
+
+	userGroups, err := userGroupsList(ctx, client)
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to list User Groups, got error: %s", err))
+		stream.Results = list.ListResultsStreamDiagnostics(diags)
+		return
+	}
+
+	stream.Results = func(push func(list.ListResult) bool) {
+		for i, userGroup := range userGroups {
+			if req.Limit > 0 && int64(i) >= req.Limit {
+				return
+			}
+
+			result := req.NewListResult(ctx)
+			result.DisplayName = userGroup.Name
+
+			result.Diagnostics.Append(client.setIdentity(ctx, result.Identity, userGroup.ID)...)
+
+			if req.IncludeResource {
+				result.Diagnostics.Append(result.Resource.Set(ctx, UserGroupResourceModel{
+					Id:              types.StringValue(userGroup.ID),
+					Name:            types.StringValue(userGroup.Name),
+					Handle:          types.StringValue(userGroup.Handle),
+					Description:     types.StringValue(userGroup.Description),
+					AdoptExisting:   types.BoolNull(),
+					ActionOnDestroy: types.StringNull(),
+				})...)
+			}
+
+			if !push(result) {
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,64 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck"
+	"github.com/hashicorp/terraform-plugin-testing/querycheck/queryfilter"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccUserGroupListResource(t *testing.T) {
+	testUserGroupName := "test-usergroup-" + testAccNameSuffix(t)
+
+	resource.Test(t, resource.TestCase{
+		ProtoV6ProviderFactories: testAccProviders(t),
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			// List resources require Terraform 1.14.0 or later.
+			tfversion.SkipBelow(tfversion.Version1_14_0),
+		},
+		Steps: []resource.TestStep{
+			// Create a User Group to list
+			{
+				Config: providerConfig + `
+resource "slack_usergroup" "test" {
+  name = "` + testUserGroupName + `"
+}
+`,
+			},
+			// Query testing
+			{
+				Query: true,
+				Config: providerConfig + `
+list "slack_usergroup" "test" {
+  provider         = slack
+  include_resource = true
+}
+`,
+				QueryResultChecks: []querycheck.QueryResultCheck{
+					querycheck.ExpectLengthAtLeast("slack_usergroup.test", 1),
+					querycheck.ExpectResourceKnownValues(
+						"slack_usergroup.test",
+						queryfilter.ByDisplayName(knownvalue.StringExact(testUserGroupName)),
+						[]querycheck.KnownValueCheck{
+							{
+								Path:       tfjsonpath.New("name"),
+								KnownValue: knownvalue.StringExact(testUserGroupName),
+							},
+							{
+								Path:       tfjsonpath.New("handle"),
+								KnownValue: knownvalue.StringExact(""),
+							},
+						},
+					),
+				},
+			},
+		},
+	})
+}
@@ -0,0 +1,123 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &UserGroupMembershipDataSource{}
+	_ datasource.DataSourceWithConfigure = &UserGroupMembershipDataSource{}
+)
+
+func NewUserGroupMembershipDataSource() datasource.DataSource {
+	return &UserGroupMembershipDataSource{}
+}
+
+// UserGroupMembershipDataSource defines the data source implementation.
+type UserGroupMembershipDataSource struct {
+	client *SlackClient
+}
+
+// UserGroupMembershipDataSourceModel describes the data source data model.
+type UserGroupMembershipDataSourceModel struct {
+	Id          types.String `tfsdk:"id"`
+	UserGroupId types.String `tfsdk:"usergroup_id"`
+	UserId      types.String `tfsdk:"user_id"`
+	IsMember    types.Bool   `tfsdk:"is_member"`
+}
+
+func (d *UserGroupMembershipDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_usergroup_membership"
+}
+
+func (d *UserGroupMembershipDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Checks whether a user is a member of a User Group, without keeping the User Group's members in state.
+### Required Permissions
+- ` + "`usergroups:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "ID of the membership, in the form `usergroup_id:user_id`.",
+				Computed:            true,
+			},
+			"usergroup_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the User Group.",
+				Required:            true,
+			},
+			"user_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the user.",
+				Required:            true,
+			},
+			"is_member": schema.BoolAttribute{
+				MarkdownDescription: "Whether the user is a member of the User Group.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *UserGroupMembershipDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *UserGroupMembershipDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data UserGroupMembershipDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var members []string
+
+	err := d.client.retry(ctx, "usergroups.users.list", func() (err error) {
+		members, err = d.client.GetUserGroupMembersContext(ctx, data.UserGroupId.ValueString())
+		return err
+	})
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find User Group members, got error: %s", err))
+		return
+	}
+
+	// Set data from API response.
+	data.Id = types.StringValue(data.UserGroupId.ValueString() + ":" + data.UserId.ValueString())
+	data.IsMember = types.BoolValue(slices.Contains(members, data.UserId.ValueString()))
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,49 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccUserGroupMembershipDataSource(t *testing.T) {
+	userGroupId := testAccFixture(t, testEnvUserGroupId)
+	memberId := testAccFixture(t, testEnvUserGroupMember)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + testAccUserGroupMembershipDataSourceConfig(userGroupId, memberId),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_usergroup_membership.test", "id", userGroupId+":"+memberId),
+					resource.TestCheckResourceAttr("data.slack_usergroup_membership.test", "is_member", "true"),
+				),
+			},
+			{
+				Config: providerConfig + testAccUserGroupMembershipDataSourceConfig(userGroupId, "UDOESNOTEXIST"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr("data.slack_usergroup_membership.test", "is_member", "false"),
+				),
+			},
+			{
+				Config:      providerConfig + testAccUserGroupMembershipDataSourceConfig("SDOESNOTEXIST", memberId),
+				ExpectError: regexp.MustCompile(`Unable to find User Group members`),
+			},
+		},
+	})
+}
+
+func testAccUserGroupMembershipDataSourceConfig(userGroupId string, userId string) string {
+	return `
+data "slack_usergroup_membership" "test" {
+  usergroup_id = "` + userGroupId + `"
+  user_id      = "` + userId + `"
+}
+`
+}
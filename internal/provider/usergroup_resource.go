@@ -6,23 +6,33 @@ package provider
 import (
 	"context"
 	"fmt"
-	"time"
+	"strings"
 
 	"github.com/slack-go/slack"
 
-	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
-	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
 	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 )
 
+const (
+	userGroupActionDisable = "disable"
+	userGroupActionNone    = "none"
+)
+
 // Ensure provider defined types fully satisfy framework interfaces.
 var _ resource.Resource = &UserGroupResource{}
 var _ resource.ResourceWithImportState = &UserGroupResource{}
+var _ resource.ResourceWithIdentity = &UserGroupResource{}
+var _ resource.ResourceWithMoveState = &UserGroupResource{}
+var _ resource.ResourceWithUpgradeState = &UserGroupResource{}
+var _ resource.ResourceWithModifyPlan = &UserGroupResource{}
 
 func NewUserGroupResource() resource.Resource {
 	return &UserGroupResource{}
@@ -30,7 +40,7 @@ func NewUserGroupResource() resource.Resource {
 
 // UserGroupResource defines the resource implementation.
 type UserGroupResource struct {
-	client *slack.Client
+	client *SlackClient
 }
 
 // UserGroupResourceModel describes the resource data model.
@@ -39,6 +49,9 @@ type UserGroupResourceModel struct {
 	Handle      types.String `tfsdk:"handle"`
 	Name        types.String `tfsdk:"name"`
 	Description types.String `tfsdk:"description"`
+
+	AdoptExisting   types.Bool   `tfsdk:"adopt_existing"`
+	ActionOnDestroy types.String `tfsdk:"action_on_destroy"`
 }
 
 func (r *UserGroupResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
@@ -47,6 +60,7 @@ func (r *UserGroupResource) Metadata(ctx context.Context, req resource.MetadataR
 
 func (r *UserGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	resp.Schema = schema.Schema{
+		Version: 1,
 		// This description is used by the documentation generator and the language server.
 		MarkdownDescription: `
 Creates a Slack User Group.
@@ -66,10 +80,9 @@ Creates a Slack User Group.
 				Required:            true,
 			},
 			"handle": schema.StringAttribute{
-				MarkdownDescription: "A mention handle. Must be unique among channels, users and User Groups.",
-				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
+				MarkdownDescription: "A mention handle. Must be unique among channels, users and User Groups. " +
+					"Leave unset to not manage it. Setting it to `\"\"` replaces the User Group, since a handle cannot be removed.",
+				Optional: true,
 				PlanModifiers: []planmodifier.String{
 					stringplanmodifier.RequiresReplaceIf(
 						func(
@@ -77,8 +90,9 @@ Creates a Slack User Group.
 							sr planmodifier.StringRequest,
 							rrifr *stringplanmodifier.RequiresReplaceIfFuncResponse,
 						) {
-							//nolint:staticcheck // QF1001
-							rrifr.RequiresReplace = !(sr.StateValue.ValueString() == "") && sr.PlanValue.ValueString() == ""
+							// Only an explicit "" removes the handle, an unset one
+							// is left as it is.
+							rrifr.RequiresReplace = sr.StateValue.ValueString() != "" && !sr.PlanValue.IsNull() && sr.PlanValue.ValueString() == ""
 						},
 						"Handle cannot be removed once it is set.",
 						"Handle cannot be removed once it is set.",
@@ -86,27 +100,42 @@ Creates a Slack User Group.
 				},
 			},
 			"description": schema.StringAttribute{
-				MarkdownDescription: "A short description of the User Group.",
+				MarkdownDescription: "A short description of the User Group. Leave unset to not manage it, or set to `\"\"` to clear it.",
 				Optional:            true,
-				Computed:            true,
-				Default:             stringdefault.StaticString(""),
+			},
+			"adopt_existing": schema.BoolAttribute{
+				MarkdownDescription: "Set true to take over an existing User Group of the same name on create, enabling it again if it was disabled, " +
+					"instead of failing. Has no effect once the User Group is created.",
+				Optional: true,
+			},
+			"action_on_destroy": schema.StringAttribute{
+				MarkdownDescription: "What to do with the User Group when it is destroyed. Either `disable` (default), " +
+					"or `none` to only stop managing it, leaving it enabled so existing mentions of it keep working.",
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.OneOf(userGroupActionDisable, userGroupActionNone),
+				},
 			},
 		},
 	}
 }
 
+func (r *UserGroupResource) IdentitySchema(ctx context.Context, req resource.IdentitySchemaRequest, resp *resource.IdentitySchemaResponse) {
+	resp.IdentitySchema = slackIdentitySchema("User Group")
+}
+
 func (r *UserGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	// Prevent panic if the provider has not been configured.
 	if req.ProviderData == nil {
 		return
 	}
 
-	client, ok := req.ProviderData.(*slack.Client)
+	client, ok := req.ProviderData.(*SlackClient)
 
 	if !ok {
 		resp.Diagnostics.AddError(
 			"Unexpected Resource Configure Type",
-			fmt.Sprintf("Expected *slack.Client, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
 		)
 
 		return
@@ -116,6 +145,9 @@ func (r *UserGroupResource) Configure(ctx context.Context, req resource.Configur
 }
 
 func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data UserGroupResourceModel
 	client := r.client
 
@@ -131,25 +163,42 @@ func (r *UserGroupResource) Create(ctx context.Context, req resource.CreateReque
 		Handle:      data.Handle.ValueString(),
 		Description: data.Description.ValueString(),
 	}
-	userGroup, err := client.CreateUserGroupContext(ctx, params)
+	var userGroup slack.UserGroup
 
-	if err != nil {
+	err := client.retry(ctx, "usergroups.create", func() (err error) {
+		userGroup, err = client.CreateUserGroupContext(ctx, params)
+		return err
+	})
+
+	if err != nil && err.Error() == "name_already_exists" {
+		var diags diag.Diagnostics
+		userGroup, diags = adoptUserGroup(ctx, client, data)
+		resp.Diagnostics.Append(diags...)
+
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to create User Group, got error: %s", err))
 		return
 	}
 
 	data.Id = types.StringValue(userGroup.ID)
-	data.Description = types.StringValue(userGroup.Description)
+	data.Description = managedString(data.Description, userGroup.Description)
 	data.Name = types.StringValue(userGroup.Name)
-	data.Handle = types.StringValue(userGroup.Handle)
+	data.Handle = managedString(data.Handle, userGroup.Handle)
 
-	tflog.Trace(ctx, "Created a slack User Group")
+	tflog.Trace(ctx, "Created a slack User Group", map[string]any{"usergroup_id": userGroup.ID})
 
 	// Save data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(client.setIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data UserGroupResourceModel
 	client := r.client
 
@@ -160,7 +209,9 @@ func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 		return
 	}
 
-	userGroups, err := userGroupsList(ctx, client)
+	// Disabled User Groups are listed too, so those disabled outside of
+	// Terraform can be told apart from failed lookups.
+	userGroups, err := userGroupsList(ctx, client, slack.GetUserGroupsOptionIncludeDisabled(true))
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find User Group, got error: %s", err))
@@ -169,20 +220,25 @@ func (r *UserGroupResource) Read(ctx context.Context, req resource.ReadRequest,
 
 	userGroup, err := getUserGroupById(&userGroups, data.Id.ValueString())
 
-	if err != nil {
-		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to find User Group, got error: %s", err))
+	if err != nil || userGroup.DateDelete != 0 {
+		tflog.Info(ctx, "Slack User Group was disabled or removed outside of Terraform, removing it from state", map[string]any{"usergroup_id": data.Id.ValueString()})
+		resp.State.RemoveResource(ctx)
 		return
 	}
 
 	data.Name = types.StringValue(userGroup.Name)
-	data.Description = types.StringValue(userGroup.Description)
-	data.Handle = types.StringValue(userGroup.Handle)
+	data.Description = managedString(data.Description, userGroup.Description)
+	data.Handle = managedString(data.Handle, userGroup.Handle)
 
 	// Save updated data into Terraform state
 	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+	resp.Diagnostics.Append(client.setIdentity(ctx, resp.Identity, data.Id.ValueString())...)
 }
 
 func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var plan, state UserGroupResourceModel
 
 	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
@@ -200,7 +256,12 @@ func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateReque
 		slack.UpdateUserGroupsOptionDescription(plan.Description.ValueStringPointer()),
 	}
 
-	userGroup, err := client.UpdateUserGroupContext(ctx, plan.Id.ValueString(), params...)
+	var userGroup slack.UserGroup
+
+	err := client.retry(ctx, "usergroups.update", func() (err error) {
+		userGroup, err = client.UpdateUserGroupContext(ctx, plan.Id.ValueString(), params...)
+		return err
+	})
 
 	if err != nil {
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to Update User Group, got error: %s", err))
@@ -208,13 +269,17 @@ func (r *UserGroupResource) Update(ctx context.Context, req resource.UpdateReque
 	}
 
 	plan.Name = types.StringValue(userGroup.Name)
-	plan.Description = types.StringValue(userGroup.Description)
-	plan.Handle = types.StringValue(userGroup.Handle)
+	plan.Description = managedString(plan.Description, userGroup.Description)
+	plan.Handle = managedString(plan.Handle, userGroup.Handle)
 
 	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+	resp.Diagnostics.Append(client.setIdentity(ctx, resp.Identity, plan.Id.ValueString())...)
 }
 
 func (r *UserGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
 	var data UserGroupResourceModel
 	client := r.client
 
@@ -225,53 +290,302 @@ func (r *UserGroupResource) Delete(ctx context.Context, req resource.DeleteReque
 		return
 	}
 
-	_, err := client.DisableUserGroupContext(
-		ctx, data.Id.ValueString(),
-	)
+	if data.ActionOnDestroy.ValueString() == userGroupActionNone {
+		tflog.Info(ctx, "Leaving slack User Group enabled on destroy", map[string]any{"usergroup_id": data.Id.ValueString()})
+		return
+	}
+
+	err := client.retry(ctx, "usergroups.disable", func() error {
+		_, err := client.DisableUserGroupContext(
+			ctx, data.Id.ValueString(),
+		)
+		return err
+	})
 
 	if err != nil {
+		// The User Group may have been disabled or removed out of band since
+		// it was last read.
+		switch err.Error() {
+		case "already_disabled", "no_such_subteam":
+			return
+		}
 		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to disable User Group, got error: %s", err))
 		return
 	}
 
 }
 
-func (r *UserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
-	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+// ModifyPlan checks the name and handle a User Group is planned to get
+// against the workspace when check_usergroup_conflicts is set, so conflicts
+// fail the plan rather than the apply, after other changes were made.
+func (r *UserGroupResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if r.client == nil || !r.client.checkUserGroupConflicts || req.Plan.Raw.IsNull() {
+		return
+	}
+
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var plan UserGroupResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+
+	var state *UserGroupResourceModel
+	if !req.State.Raw.IsNull() {
+		state = &UserGroupResourceModel{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+	}
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Only names and handles the plan changes are checked.
+	var id, name, handle string
+	if state != nil {
+		id = state.Id.ValueString()
+	}
+	if !plan.Name.IsUnknown() && (state == nil || plan.Name != state.Name) {
+		name = plan.Name.ValueString()
+	}
+	if !plan.Handle.IsUnknown() && (state == nil || plan.Handle != state.Handle) {
+		handle = plan.Handle.ValueString()
+	}
+	if name == "" && handle == "" {
+		return
+	}
+
+	conflicts, err := userGroupConflicts(ctx, r.client, id, name, handle, plan.AdoptExisting.ValueBool() && state == nil)
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to check User Group for conflicts, got error: %s", err))
+		return
+	}
+
+	if len(conflicts) > 0 {
+		resp.Diagnostics.AddError(
+			"User Group Conflicts",
+			fmt.Sprintf("The User Group %q cannot be applied, as:\n\n- %s", plan.Name.ValueString(), strings.Join(conflicts, "\n- ")),
+		)
+	}
 }
 
-func userGroupsList(ctx context.Context, api *slack.Client) ([]slack.UserGroup, error) {
+func (r *UserGroupResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	r.client.importStateWithIdentity(ctx, req, resp)
+}
 
-	var err error
+// adoptUserGroup takes over the existing User Group with the name in data,
+// enabling it if it was disabled and updating it to match data. Unless
+// adopt_existing is set, it fails with an error naming the existing User
+// Group so it can be imported instead.
+func adoptUserGroup(ctx context.Context, client *SlackClient, data UserGroupResourceModel) (slack.UserGroup, diag.Diagnostics) {
+	var diags diag.Diagnostics
 	var userGroups []slack.UserGroup
 
-	err = nil
+	err := client.retry(ctx, "usergroups.list", func() (err error) {
+		userGroups, err = client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeDisabled(true))
+		return err
+	})
 
-	for err == nil {
-		userGroups, err = api.GetUserGroupsContext(
-			ctx,
-		)
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to find existing User Group: %s, got error: %s", data.Name.ValueString(), err))
+		return slack.UserGroup{}, diags
+	}
 
-		if err == nil {
+	var existing *slack.UserGroup
+	for i := range userGroups {
+		if userGroups[i].Name == data.Name.ValueString() {
+			existing = &userGroups[i]
 			break
 		}
+	}
 
-		if rateLimitedError, ok := err.(*slack.RateLimitedError); ok {
+	if existing == nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to create User Group: %s, got error: name_already_exists", data.Name.ValueString()))
+		return slack.UserGroup{}, diags
+	}
 
-			tflog.Debug(ctx, rateLimitedError.Error())
-			select {
-			case <-ctx.Done():
-				err = ctx.Err()
-				tflog.Error(ctx, "Context is Done. "+err.Error())
-			case <-time.After(rateLimitedError.RetryAfter):
-				err = nil
-			}
-		}
+	if !data.AdoptExisting.ValueBool() {
+		diags.AddError(
+			"User Group Already Exists",
+			fmt.Sprintf("A User Group named %q already exists with ID %s. Import it with:\n\n"+
+				"  terraform import <address> %s\n\n"+
+				"or set adopt_existing = true to manage it.", existing.Name, existing.ID, existing.ID),
+		)
+		return slack.UserGroup{}, diags
+	}
+
+	tflog.Info(ctx, "Adopting existing slack User Group", map[string]any{"usergroup_id": existing.ID})
+
+	if existing.DateDelete != 0 {
+		err := client.retry(ctx, "usergroups.enable", func() error {
+			_, err := client.EnableUserGroupContext(ctx, existing.ID)
+			return err
+		})
 
 		if err != nil {
-			return nil, fmt.Errorf("couldn't get conversation context: %s", err.Error())
+			diags.AddError("Client Error", fmt.Sprintf("Unable to enable existing User Group: %s, got error: %s", existing.ID, err))
+			return slack.UserGroup{}, diags
 		}
 	}
 
+	var userGroup slack.UserGroup
+
+	err = client.retry(ctx, "usergroups.update", func() (err error) {
+		userGroup, err = client.UpdateUserGroupContext(
+			ctx,
+			existing.ID,
+			slack.UpdateUserGroupsOptionHandle(data.Handle.ValueString()),
+			slack.UpdateUserGroupsOptionDescription(data.Description.ValueStringPointer()),
+		)
+		return err
+	})
+
+	if err != nil {
+		diags.AddError("Client Error", fmt.Sprintf("Unable to update existing User Group: %s, got error: %s", existing.ID, err))
+		return slack.UserGroup{}, diags
+	}
+
+	return userGroup, diags
+}
+
+// managedString returns value as the new value of an attribute whose current
+// value is current, unless it is null and so not managed.
+func managedString(current types.String, value string) types.String {
+	if current.IsNull() {
+		return current
+	}
+	return types.StringValue(value)
+}
+
+func userGroupsList(ctx context.Context, api *SlackClient, options ...slack.GetUserGroupsOption) ([]slack.UserGroup, error) {
+	var userGroups []slack.UserGroup
+
+	err := api.retry(ctx, "usergroups.list", func() (err error) {
+		userGroups, err = api.GetUserGroupsContext(
+			ctx, options...,
+		)
+		return err
+	})
+
+	if err != nil {
+		return nil, fmt.Errorf("couldn't get conversation context: %s", err.Error())
+	}
+
 	return userGroups, nil
 }
+
+// userGroupConflicts returns a description of each conflict of the given
+// name and handle with the workspace: a name of another User Group, or a
+// handle of another User Group, a user or a channel. Empty values and the
+// User Group with the given id are not checked. With adopt, the User Group of
+// the same name is the one the handle is checked for.
+func userGroupConflicts(ctx context.Context, client *SlackClient, id string, name string, handle string, adopt bool) ([]string, error) {
+	var conflicts []string
+	var userGroups []slack.UserGroup
+
+	// Disabled User Groups keep their names and handles.
+	err := client.retry(ctx, "usergroups.list", func() (err error) {
+		userGroups, err = client.GetUserGroupsContext(ctx, slack.GetUserGroupsOptionIncludeDisabled(true))
+		return err
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	if adopt {
+		for _, userGroup := range userGroups {
+			if userGroup.Name == name {
+				id = userGroup.ID
+			}
+		}
+	}
+
+	for _, userGroup := range userGroups {
+		if userGroup.ID == id {
+			continue
+		}
+		if name != "" && userGroup.Name == name {
+			conflicts = append(conflicts, fmt.Sprintf("its name is taken by User Group %s", userGroup.ID))
+		}
+		if handle != "" && userGroup.Handle == handle {
+			conflicts = append(conflicts, fmt.Sprintf("its handle is taken by User Group %s (%s)", userGroup.ID, userGroup.Name))
+		}
+	}
+
+	if handle == "" {
+		return conflicts, nil
+	}
+
+	user, err := getUserByName(ctx, client, handle)
+	if err == nil {
+		conflicts = append(conflicts, fmt.Sprintf("its handle is taken by user %s", user.ID))
+	} else if !isUserNotFound(err) {
+		return nil, err
+	}
+
+	channel, err := getChannelByName(ctx, client, handle, false, nil)
+	if err == nil {
+		conflicts = append(conflicts, fmt.Sprintf("its handle is taken by channel %s", channel.ID))
+	} else if err.Error() != "channel_not_found" {
+		return nil, err
+	}
+
+	return conflicts, nil
+}
+
+// communityUserGroupState describes the parts of the state of a
+// pablovarela/slack slack_usergroup that carry over to a slack_usergroup.
+type communityUserGroupState struct {
+	Id          string `json:"id"`
+	Name        string `json:"name"`
+	Handle      string `json:"handle"`
+	Description string `json:"description"`
+}
+
+func (r *UserGroupResource) UpgradeState(ctx context.Context) map[int64]resource.StateUpgrader {
+	var schemaResp resource.SchemaResponse
+	r.Schema(ctx, resource.SchemaRequest{}, &schemaResp)
+
+	return map[int64]resource.StateUpgrader{
+		// Version 0 stored an unset handle and description as "".
+		0: unsetEmptyStringsUpgrader(schemaResp.Schema, "handle", "description"),
+	}
+}
+
+func (r *UserGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if !movesFromCommunityProvider(req, "slack_usergroup") {
+					return
+				}
+
+				var source communityUserGroupState
+
+				resp.Diagnostics.Append(decodeSourceState(req, &source)...)
+
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				// Default channels are managed by slack_usergroup_channel
+				// resources, and members are not managed by this provider, so
+				// channels and users do not carry over.
+				data := UserGroupResourceModel{
+					Id:              types.StringValue(source.Id),
+					Name:            types.StringValue(source.Name),
+					Handle:          optionalString(source.Handle),
+					Description:     optionalString(source.Description),
+					AdoptExisting:   types.BoolNull(),
+					ActionOnDestroy: types.StringNull(),
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.Set(ctx, &data)...)
+
+				if r.client != nil {
+					resp.Diagnostics.Append(r.client.setIdentity(ctx, resp.TargetIdentity, source.Id)...)
+				}
+			},
+		},
+	}
+}
@@ -0,0 +1,159 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/function"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var _ function.Function = &ValidateEmojiNameFunction{}
+
+func NewValidateEmojiNameFunction() function.Function {
+	return &ValidateEmojiNameFunction{}
+}
+
+// ValidateEmojiNameFunction defines the function implementation.
+type ValidateEmojiNameFunction struct{}
+
+// emojiNameMaxLength is the longest name Slack allows for custom emoji.
+const emojiNameMaxLength = 100
+
+// standardEmojiNames holds the names of common standard emoji, which custom
+// emoji cannot take. It is not exhaustive; Slack rejects the rest.
+var standardEmojiNames = toSet(strings.Fields(`
+	+1 -1 100 1234 8ball a ab abc abcd accept aerial_tramway airplane alarm_clock alien ambulance anchor angel anger angry
+	anguished ant apple aquarius aries arrow_down arrow_left arrow_right arrow_up art astonished athletic_shoe atm b baby
+	baby_bottle back bacon badminton_racquet_and_shuttlecock balloon ballot_box_with_check bamboo banana bangbang bank
+	bar_chart barber baseball basketball bath bathtub battery bear beer beers beetle beginner bell bento bicyclist bike
+	bikini bird birthday black_circle black_heart blossom blowfish blue_book blue_heart blush boar boat bomb book bookmark
+	books boom boot bouquet bow bowling boy bread bride_with_veil bridge_at_night briefcase broken_heart bug bulb bullettrain_front
+	burrito bus busstop bust_in_silhouette busts_in_silhouette butterfly cactus cake calendar calling camel camera cancer
+	candle candy capital_abcd capricorn car card_index carousel_horse cat cat2 cd chart chart_with_downwards_trend
+	chart_with_upwards_trend checkered_flag cheese_wedge cherries cherry_blossom chestnut chicken children_crossing
+	chocolate_bar christmas_tree church cinema circus_tent city_sunset cl clap clapper clipboard clock1 closed_book
+	closed_lock_with_key closed_umbrella cloud clubs cocktail coffee cold_sweat collision computer confetti_ball confounded
+	confused congratulations construction cookie cool cop copyright corn couple cow cow2 crab credit_card crescent_moon
+	cricket crocodile crossed_fingers crown cry crying_cat_face crystal_ball cupid curly_loop currency_exchange curry
+	custard customs cyclone dancer dango dart dash date deer department_store desert diamonds disappointed
+	disappointed_relieved dizzy dizzy_face do_not_litter dog dog2 dollar dolls dolphin door doughnut dove dragon dragon_face
+	dress dromedary_camel droplet dvd e-mail eagle ear ear_of_rice earth_africa earth_americas earth_asia egg eggplant eight
+	eject electric_plug elephant email end envelope euro european_castle exclamation expressionless eyeglasses eyes face_palm
+	facepunch factory fallen_leaf family fast_forward fax fearful feet ferris_wheel file_folder fire fire_engine fireworks
+	first_quarter_moon fish fish_cake fishing_pole_and_fish fist five flags flashlight floppy_disk flower_playing_cards
+	flushed foggy football fork_and_knife fountain four four_leaf_clover fox_face free fried_shrimp fries frog frowning
+	fuelpump full_moon game_die gem gemini ghost gift gift_heart girl globe_with_meridians goat golf grapes green_apple
+	green_book green_heart grey_exclamation grey_question grimacing grin grinning guardsman guitar gun haircut hamburger
+	hammer hamster hand handbag handshake hankey hash hatched_chick hatching_chick headphones hear_no_evil heart
+	heart_decoration heart_eyes heart_eyes_cat heartbeat heartpulse hearts heavy_check_mark heavy_dollar_sign
+	heavy_minus_sign heavy_multiplication_x heavy_plus_sign helicopter herb hibiscus high_brightness high_heel hocho
+	honey_pot horse horse_racing hospital hotel hotsprings hourglass house house_with_garden hugging_face hushed ice_cream
+	icecream id ideograph_advantage imp inbox_tray incoming_envelope information_desk_person information_source innocent
+	interrobang iphone izakaya_lantern jack_o_lantern japan japanese_castle japanese_goblin japanese_ogre jeans joy joy_cat
+	key keyboard keycap_ten kimono kiss kissing kissing_cat kissing_closed_eyes kissing_heart kissing_smiling_eyes koala
+	koko label large_blue_circle large_blue_diamond large_orange_diamond last_quarter_moon laughing leaves ledger
+	left_right_arrow lemon leo leopard libra light_rail link lips lipstick lock lock_with_ink_pen lollipop loop loud_sound
+	loudspeaker love_hotel love_letter low_brightness m mag mag_right mahjong mailbox man mans_shoe maple_leaf mask massage
+	meat_on_bone mega melon memo mens metro microphone microscope milky_way minibus minidisc moneybag money_with_wings
+	monkey monkey_face monorail moon mortar_board mount_fuji mountain_bicyclist mountain_cableway mountain_railway mouse
+	mouse2 movie_camera moyai muscle mushroom musical_keyboard musical_note musical_score mute nail_care name_badge necktie
+	negative_squared_cross_mark nerd_face neutral_face new new_moon newspaper ng night_with_stars nine no_bell no_bicycles
+	no_entry no_entry_sign no_good no_mobile_phones no_mouth no_pedestrians no_smoking nose notebook notes nut_and_bolt o
+	o2 ocean octopus oden office ok ok_hand ok_woman older_man older_woman on oncoming_automobile oncoming_bus
+	oncoming_police_car oncoming_taxi one open_book open_file_folder open_hands open_mouth ophiuchus orange_book outbox_tray
+	ox package page_facing_up page_with_curl pager palm_tree panda_face paperclip parking part_alternation_mark
+	partly_sunny partying_face passport_control paw_prints peach pear pencil pencil2 penguin pensive performing_arts
+	persevere person_frowning person_with_blond_hair person_with_pouting_face phone pig pig2 pig_nose pill pineapple pisces
+	pizza point_down point_left point_right point_up point_up_2 police_car poodle poop post_office postal_horn postbox
+	potable_water pouch poultry_leg pound pouting_cat pray princess punch purple_heart purse pushpin put_litter_in_its_place
+	question rabbit rabbit2 racehorse radio radio_button rage rage1 railway_car rainbow raised_hand raised_hands
+	raising_hand ram ramen rat recycle red_car red_circle registered relaxed relieved repeat repeat_one restroom
+	revolving_hearts rewind ribbon rice rice_ball rice_cracker rice_scene ring robot_face rocket roller_coaster
+	rolling_on_the_floor_laughing rooster rose rotating_light round_pushpin rowboat rugby_football runner running
+	running_shirt_with_sash sa sagittarius sailboat sake sandal santa satellite satisfied saxophone school school_satchel
+	scissors scorpius scream scream_cat scroll seat secret see_no_evil seedling seven shaved_ice sheep shell ship shirt
+	shit shoe shower signal_strength six six_pointed_star ski skull slightly_frowning_face slightly_smiling_face
+	slot_machine small_blue_diamond small_orange_diamond small_red_triangle small_red_triangle_down smile smile_cat smiley
+	smiley_cat smiling_imp smirk smirk_cat smoking snail snake snowboarder snowflake snowman sob soccer soon sos sound
+	space_invader spades spaghetti sparkle sparkler sparkles sparkling_heart speak_no_evil speaker speech_balloon
+	speedboat star star2 stars station statue_of_liberty steam_locomotive stew straight_ruler strawberry stuck_out_tongue
+	stuck_out_tongue_closed_eyes stuck_out_tongue_winking_eye sun_with_face sunflower sunglasses sunny sunrise
+	sunrise_over_mountains surfer sushi suspension_railway sweat sweat_drops sweat_smile sweet_potato swimmer symbols
+	syringe tada tanabata_tree tangerine taurus taxi tea telephone telephone_receiver telescope tennis tent thinking_face
+	thought_balloon three thumbsdown thumbsup ticket tiger tiger2 tired_face tm toilet tokyo_tower tomato tongue top
+	tophat tractor traffic_light train train2 tram triangular_flag_on_post triangular_ruler trident triumph trolleybus
+	trophy tropical_drink tropical_fish truck trumpet tshirt tulip turtle tv twisted_rightwards_arrows two two_hearts
+	two_men_holding_hands two_women_holding_hands u5272 u5408 u55b6 u6307 u6708 u6709 u6e80 u7121 u7533 u7981 u7a7a uk
+	umbrella unamused underage unicorn_face unlock up upside_down_face us v vertical_traffic_light vhs vibration_mode
+	video_camera video_game violin virgo volcano vs walking waning_crescent_moon waning_gibbous_moon warning watch
+	water_buffalo watermelon wave wavy_dash waxing_crescent_moon waxing_gibbous_moon wc weary wedding whale whale2
+	wheelchair white_check_mark white_circle white_flower white_square_button wind_chime wine_glass wink wolf woman
+	womans_clothes womans_hat womens worried wrench x yellow_heart yen yum zap zero zipper_mouth_face zzz
+`))
+
+func toSet(values []string) map[string]bool {
+	set := make(map[string]bool, len(values))
+	for _, value := range values {
+		set[value] = true
+	}
+	return set
+}
+
+// validateEmojiName returns why name cannot be the name of a custom emoji, if
+// it cannot.
+func validateEmojiName(name string) error {
+	switch {
+	case name == "":
+		return fmt.Errorf("emoji names must not be empty")
+	case len(name) > emojiNameMaxLength:
+		return fmt.Errorf("emoji names must be at most %d characters long, got %d", emojiNameMaxLength, len(name))
+	case !emojiNameRegexp.MatchString(name):
+		return fmt.Errorf("emoji names must only contain lowercase letters, numbers, underscores, dashes, apostrophes and plus signs, got %q", name)
+	case standardEmojiNames[name]:
+		return fmt.Errorf("%q is the name of a standard emoji", name)
+	default:
+		return nil
+	}
+}
+
+func (f *ValidateEmojiNameFunction) Metadata(ctx context.Context, req function.MetadataRequest, resp *function.MetadataResponse) {
+	resp.Name = "validate_emoji_name"
+}
+
+func (f *ValidateEmojiNameFunction) Definition(ctx context.Context, req function.DefinitionRequest, resp *function.DefinitionResponse) {
+	resp.Definition = function.Definition{
+		Summary: "Validates the name of a custom emoji",
+		MarkdownDescription: "Returns the given name if it can be the name of a custom emoji, and fails otherwise, so invalid names are caught during planning. " +
+			"Names must be at most 100 lowercase letters, numbers, underscores, dashes, apostrophes and plus signs, and must not be taken by a " +
+			"common standard emoji. Wrap it in `can()` to use it in a variable's validation.",
+		Parameters: []function.Parameter{
+			function.StringParameter{
+				Name:                "name",
+				MarkdownDescription: "The emoji name, without colons.",
+			},
+		},
+		Return: function.StringReturn{},
+	}
+}
+
+func (f *ValidateEmojiNameFunction) Run(ctx context.Context, req function.RunRequest, resp *function.RunResponse) {
+	var name string
+
+	resp.Error = function.ConcatFuncErrors(req.Arguments.Get(ctx, &name))
+
+	if resp.Error != nil {
+		return
+	}
+
+	if err := validateEmojiName(name); err != nil {
+		resp.Error = function.NewArgumentFuncError(0, "Invalid emoji name: "+err.Error())
+		return
+	}
+
+	resp.Error = function.ConcatFuncErrors(resp.Result.Set(ctx, name))
+}
@@ -0,0 +1,61 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+)
+
+func TestAccValidateEmojiNameFunction(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: `
+output "test" {
+  value = provider::slack::validate_emoji_name("ship-it")
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.StringExact("ship-it")),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = can(provider::slack::validate_emoji_name("Ship It"))
+}
+`,
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownOutputValue("test", knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::slack::validate_emoji_name("thumbsup")
+}
+`,
+				ExpectError: regexp.MustCompile(`name of a standard emoji`),
+			},
+			{
+				Config: `
+output "test" {
+  value = provider::slack::validate_emoji_name(join("", [for i in range(101) : "a"]))
+}
+`,
+				ExpectError: regexp.MustCompile(`at most 100 characters`),
+			},
+		},
+	})
+}
@@ -0,0 +1,151 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/slack-go/slack"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// Ensure provider defined types fully satisfy framework interfaces.
+var (
+	_ datasource.DataSource              = &WorkspaceAdminsDataSource{}
+	_ datasource.DataSourceWithConfigure = &WorkspaceAdminsDataSource{}
+)
+
+func NewWorkspaceAdminsDataSource() datasource.DataSource {
+	return &WorkspaceAdminsDataSource{}
+}
+
+// WorkspaceAdminsDataSource defines the data source implementation.
+type WorkspaceAdminsDataSource struct {
+	client *SlackClient
+}
+
+// WorkspaceAdminsDataSourceModel describes the data source data model.
+type WorkspaceAdminsDataSourceModel struct {
+	Id             types.String `tfsdk:"id"`
+	AdminIds       types.Set    `tfsdk:"admin_ids"`
+	OwnerIds       types.Set    `tfsdk:"owner_ids"`
+	PrimaryOwnerId types.String `tfsdk:"primary_owner_id"`
+}
+
+func (d *WorkspaceAdminsDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_workspace_admins"
+}
+
+func (d *WorkspaceAdminsDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		// This description is used by the documentation generator and the language server.
+		MarkdownDescription: `
+Gets the admins and owners of the provider's workspace, for example to invite them to alerting channels.
+Deactivated users and bots are left out.
+### Required Permissions
+- ` + "`users:read`" + `
+`,
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace the users were read from.",
+				Computed:            true,
+			},
+			"admin_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of the IDs of the workspace's admins, including its owners.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"owner_ids": schema.SetAttribute{
+				MarkdownDescription: "Set of the IDs of the workspace's owners, including its primary owner.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"primary_owner_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the workspace's primary owner.",
+				Computed:            true,
+			},
+		},
+	}
+}
+
+func (d *WorkspaceAdminsDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	// Prevent panic if the provider has not been configured.
+	if req.ProviderData == nil {
+		return
+	}
+
+	client, ok := req.ProviderData.(*SlackClient)
+
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Data Source Configure Type",
+			fmt.Sprintf("Expected *SlackClient, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+
+		return
+	}
+
+	d.client = client
+}
+
+func (d *WorkspaceAdminsDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	ctx, reportRateLimits := trackRateLimits(ctx)
+	defer reportRateLimits(&resp.Diagnostics)
+
+	var data WorkspaceAdminsDataSourceModel
+
+	// Read Terraform configuration data into the model
+	resp.Diagnostics.Append(req.Config.Get(ctx, &data)...)
+
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Roles change without users being added, so cached users are never
+	// accepted.
+	users, err := listUsers(ctx, d.client, func(slack.User) bool { return false })
+
+	if err != nil {
+		resp.Diagnostics.AddError("Client Error", fmt.Sprintf("Unable to list users, got error: %s", err))
+		return
+	}
+
+	adminIds := []string{}
+	ownerIds := []string{}
+	primaryOwnerId := types.StringNull()
+	for _, user := range users {
+		if user.Deleted || user.IsBot {
+			continue
+		}
+		if user.IsAdmin || user.IsOwner {
+			adminIds = append(adminIds, user.ID)
+		}
+		if user.IsOwner {
+			ownerIds = append(ownerIds, user.ID)
+		}
+		if user.IsPrimaryOwner {
+			primaryOwnerId = types.StringValue(user.ID)
+		}
+	}
+
+	// Set data from API response.
+	data.Id = types.StringValue(d.client.teamId)
+	data.PrimaryOwnerId = primaryOwnerId
+
+	var diags diag.Diagnostics
+
+	data.AdminIds, diags = types.SetValueFrom(ctx, types.StringType, adminIds)
+	resp.Diagnostics.Append(diags...)
+
+	data.OwnerIds, diags = types.SetValueFrom(ctx, types.StringType, ownerIds)
+	resp.Diagnostics.Append(diags...)
+
+	// Save data into Terraform state
+	resp.Diagnostics.Append(resp.State.Set(ctx, &data)...)
+}
@@ -0,0 +1,30 @@
+// Copyright github.com/mw-root 2021, 2026
+// SPDX-License-Identifier: MPL-2.0
+
+package provider
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccWorkspaceAdminsDataSource(t *testing.T) {
+	adminId := testAccFixture(t, testEnvAdminUserId)
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccProviders(t),
+		Steps: []resource.TestStep{
+			{
+				Config: providerConfig + `
+data "slack_workspace_admins" "test" {}
+`,
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.slack_workspace_admins.test", "id"),
+					resource.TestCheckTypeSetElemAttr("data.slack_workspace_admins.test", "admin_ids.*", adminId),
+				),
+			},
+		},
+	})
+}